@@ -437,3 +437,37 @@ func (c K) At(ii, jj int) ScalarExpression {
 
 	return c
 }
+
+/*
+Eval
+Description:
+
+	Evaluates the constant. The assignment is not used, because a constant
+	contains no variables.
+*/
+func (c K) Eval(assignment map[Variable]float64) (float64, error) {
+	return float64(c), nil
+}
+
+/*
+SubstituteVector
+Description:
+
+	Substitutes each variable in the vector in with the matching element of
+	replacement.
+*/
+func (c K) SubstituteVector(in VariableVector, replacement VectorExpression) Expression {
+	// Input Processing
+	err := c.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	subMap, err := substitutionMapOf(in, replacement)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return c.SubstituteAccordingTo(subMap)
+}
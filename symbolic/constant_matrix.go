@@ -329,17 +329,21 @@ func (km KMatrix) Multiply(e interface{}) Expression {
 			}
 			return out
 		} else {
-			nC := km.Dims()[1]
-			// If the output is a vector, return a vector
-			var outputVec PolynomialVector = VecDenseToKVector(
-				ZerosVector(nR),
-			).ToPolynomialVector()
-			for colIndex := 0; colIndex < nC; colIndex++ {
-				kmAsDense := km.ToDense()
-				tempCol := (&kmAsDense).ColView(colIndex)
-				outputVec = outputVec.Plus(
-					right[colIndex].Multiply(tempCol),
-				).(PolynomialVector)
+			// If the output is a vector, return a vector whose entries are
+			// the linear combinations of right given by each row of km
+			var outputVec PolynomialVector = make([]Polynomial, nR)
+			for rIndex, kmRow := range km {
+				for cIndex, kmRC := range kmRow {
+					outputVec[rIndex].Monomials = append(
+						outputVec[rIndex].Monomials,
+						Monomial{
+							Coefficient:     float64(kmRC),
+							VariableFactors: []Variable{right[cIndex]},
+							Exponents:       []int{1},
+						},
+					)
+				}
+				outputVec[rIndex] = outputVec[rIndex].Simplify()
 			}
 			return outputVec
 		}
@@ -591,6 +595,73 @@ func Identity(dim int) mat.Dense {
 	return zeroBase
 }
 
+/*
+checkPositiveDims
+Description:
+
+	Returns an error if either of the requested matrix dimensions is not positive.
+*/
+func checkPositiveDims(nR, nC int) error {
+	if nR <= 0 || nC <= 0 {
+		return fmt.Errorf(
+			"matrix dimensions must be positive; received %v x %v",
+			nR, nC,
+		)
+	}
+	return nil
+}
+
+/*
+ZerosK
+Description:
+
+	Returns a KMatrix of all zeros.
+*/
+func ZerosK(nR, nC int) KMatrix {
+	// Input Processing
+	err := checkPositiveDims(nR, nC)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return DenseToKMatrix(ZerosMatrix(nR, nC))
+}
+
+/*
+OnesK
+Description:
+
+	Returns a KMatrix of all ones.
+*/
+func OnesK(nR, nC int) KMatrix {
+	// Input Processing
+	err := checkPositiveDims(nR, nC)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return DenseToKMatrix(OnesMatrix(nR, nC))
+}
+
+/*
+IdentityK
+Description:
+
+	Returns the dim x dim identity matrix as a KMatrix.
+*/
+func IdentityK(dim int) KMatrix {
+	// Input Processing
+	err := checkPositiveDims(dim, dim)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return DenseToKMatrix(Identity(dim))
+}
+
 /*
 DerivativeWrt
 Description:
@@ -746,3 +817,232 @@ Description:
 func (km KMatrix) Power(exponent int) Expression {
 	return MatrixPowerTemplate(km, exponent)
 }
+
+/*
+RankAndDependentRows
+Description:
+
+	Computes the numerical rank of the constant matrix (via the singular value
+	decomposition) and returns the indices of the rows that are linear combinations
+	of the rows before them. Singular values at or below tol are treated as zero.
+*/
+func (km KMatrix) RankAndDependentRows(tol float64) (rank int, dependent []int) {
+	// Input Processing
+	err := km.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	kmAsDense := km.ToDense()
+	rank = numericalRank(&kmAsDense, tol)
+
+	// Add the rows one at a time; a row is dependent if it does not raise the rank
+	nCols := km.Dims()[1]
+	prevRank := 0
+	for ii := range km {
+		leadingRows := kmAsDense.Slice(0, ii+1, 0, nCols)
+		rankII := numericalRank(leadingRows, tol)
+		if rankII == prevRank {
+			dependent = append(dependent, ii)
+		}
+		prevRank = rankII
+	}
+
+	return rank, dependent
+}
+
+/*
+numericalRank
+Description:
+
+	Returns the number of singular values of the matrix that are larger than tol.
+*/
+func numericalRank(m mat.Matrix, tol float64) int {
+	// Algorithm
+	var svd mat.SVD
+	ok := svd.Factorize(m, mat.SVDNone)
+	if !ok {
+		panic(fmt.Errorf("singular value decomposition failed for matrix %v", mat.Formatted(m)))
+	}
+
+	rank := 0
+	for _, sigma := range svd.Values(nil) {
+		if sigma > tol {
+			rank++
+		}
+	}
+	return rank
+}
+
+/*
+ElementWisePower
+Description:
+
+	Raises each element of the matrix to the power of the input integer.
+	(Power, by contrast, follows the matrix semantics of the Expression interface.)
+*/
+func (km KMatrix) ElementWisePower(exponent int) Expression {
+	return MatrixElementWisePowerTemplate(km, exponent)
+}
+
+/*
+Round
+Description:
+
+	Cleans up floating point residue in the matrix. Each element within tol of
+	an integer is snapped to that integer (so elements smaller than tol in
+	magnitude become 0).
+*/
+func (km KMatrix) Round(tol float64) KMatrix {
+	// Input Processing
+	err := km.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out KMatrix
+	for _, kmRow := range km {
+		var tempRow []K
+		for _, element := range kmRow {
+			tempRow = append(tempRow, K(roundWithin(float64(element), tol)))
+		}
+		out = append(out, tempRow)
+	}
+
+	return out
+}
+
+/*
+KroneckerProduct
+Description:
+
+	Computes the Kronecker (tensor) product of the two constant matrices.
+	If left is m1 x n1 and right is m2 x n2, then the result is the
+	(m1*m2) x (n1*n2) block matrix whose (ii,jj) block is left[ii][jj] * right.
+*/
+func KroneckerProduct(left, right KMatrix) KMatrix {
+	// Input Processing
+	err := left.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = right.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Constants
+	m1, n1 := left.Dims()[0], left.Dims()[1]
+	m2, n2 := right.Dims()[0], right.Dims()[1]
+
+	// Algorithm
+	var product KMatrix
+	for ii := 0; ii < m1*m2; ii++ {
+		tempRow := make([]K, n1*n2)
+		for jj := 0; jj < n1*n2; jj++ {
+			tempRow[jj] = left[ii/m2][jj/n2] * right[ii%m2][jj%n2]
+		}
+		product = append(product, tempRow)
+	}
+
+	return product
+}
+
+/*
+Sum
+Description:
+
+	Adds together all of the elements of the matrix.
+*/
+func (km KMatrix) Sum() ScalarExpression {
+	return MatrixSumTemplate(km)
+}
+
+/*
+SubMatrix
+Description:
+
+	Returns a new matrix containing the block of the constant matrix with
+	row indices in [rowStart, rowEnd) and column indices in [colStart, colEnd).
+*/
+func (km KMatrix) SubMatrix(rowStart, rowEnd, colStart, colEnd int) MatrixExpression {
+	// Input Processing
+	err := km.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = checkSubMatrixRange(rowStart, rowEnd, colStart, colEnd, km)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out KMatrix
+	for ii := rowStart; ii < rowEnd; ii++ {
+		var tempRow []K
+		for jj := colStart; jj < colEnd; jj++ {
+			tempRow = append(tempRow, km[ii][jj])
+		}
+		out = append(out, tempRow)
+	}
+
+	return out
+}
+
+/*
+Row
+Description:
+
+	Returns row ii of the constant matrix as a vector expression.
+*/
+func (km KMatrix) Row(ii int) VectorExpression {
+	return MatrixRowTemplate(km, ii)
+}
+
+/*
+Column
+Description:
+
+	Returns column jj of the constant matrix as a vector expression.
+*/
+func (km KMatrix) Column(jj int) VectorExpression {
+	return MatrixColumnTemplate(km, jj)
+}
+
+/*
+Inverse
+Description:
+
+	Computes the inverse of the constant matrix (using gonum's LU based inverse).
+	An error is returned if the matrix is not square or if it is singular (or
+	so ill-conditioned that the inverse is not reliable).
+*/
+func (km KMatrix) Inverse() (KMatrix, error) {
+	// Input Processing
+	err := km.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsSquare(km) {
+		return nil, fmt.Errorf(
+			"matrix is not square (dimensions %v); cannot compute inverse",
+			km.Dims(),
+		)
+	}
+
+	// Algorithm
+	kmAsDense := km.ToDense()
+
+	var inverse mat.Dense
+	err = inverse.Inverse(&kmAsDense)
+	if err != nil {
+		return nil, fmt.Errorf("could not invert matrix: %v", err)
+	}
+
+	return DenseToKMatrix(inverse), nil
+}
@@ -2,6 +2,7 @@ package symbolic
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
@@ -21,6 +22,12 @@ KVector
 */
 type KVector []K // Inherit all methods from mat.VecDense
 
+/*
+InfinityNorm can be given to KVector.Norm to compute the infinity norm
+(the largest absolute value) of the vector.
+*/
+const InfinityNorm = -1
+
 /*
 Len
 
@@ -646,3 +653,154 @@ Description:
 func (kv KVector) Power(exponent int) Expression {
 	return VectorPowerTemplate(kv, exponent)
 }
+
+/*
+Dot
+Description:
+
+	Computes the dot product of the vector with the vector expression other,
+	i.e., the sum of their element-wise products.
+*/
+func (kv KVector) Dot(other VectorExpression) ScalarExpression {
+	return VectorDotTemplate(kv, other)
+}
+
+/*
+ElementWisePower
+Description:
+
+	Raises each element of the vector to the power of the input integer.
+	(Power, by contrast, follows the vector semantics of the Expression interface.)
+*/
+func (kv KVector) ElementWisePower(exponent int) Expression {
+	return VectorElementWisePowerTemplate(kv, exponent)
+}
+
+/*
+SubstituteVector
+Description:
+
+	Substitutes each variable in the vector in with the matching element of
+	replacement.
+*/
+func (kv KVector) SubstituteVector(in VariableVector, replacement VectorExpression) Expression {
+	// Input Processing
+	err := kv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	subMap, err := substitutionMapOf(in, replacement)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return kv.SubstituteAccordingTo(subMap)
+}
+
+/*
+Norm
+Description:
+
+	Computes the p-norm of the constant vector. Supported values of p are
+	1 (the sum of absolute values), 2 (the Euclidean norm) and InfinityNorm
+	(the largest absolute value).
+*/
+func (kv KVector) Norm(p int) float64 {
+	// Input Processing
+	err := kv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	norm := 0.0
+	switch p {
+	case 1:
+		for _, element := range kv {
+			norm += math.Abs(float64(element))
+		}
+	case 2:
+		for _, element := range kv {
+			norm += float64(element) * float64(element)
+		}
+		norm = math.Sqrt(norm)
+	case InfinityNorm:
+		for _, element := range kv {
+			norm = math.Max(norm, math.Abs(float64(element)))
+		}
+	default:
+		panic(
+			fmt.Errorf(
+				"KVector.Norm: unsupported norm %v; expected 1, 2 or InfinityNorm",
+				p,
+			),
+		)
+	}
+
+	return norm
+}
+
+/*
+Round
+Description:
+
+	Cleans up floating point residue in the vector. Each element within tol of
+	an integer is snapped to that integer (so elements smaller than tol in
+	magnitude become 0).
+*/
+func (kv KVector) Round(tol float64) KVector {
+	// Input Processing
+	err := kv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out KVector
+	for _, element := range kv {
+		out = append(out, K(roundWithin(float64(element), tol)))
+	}
+
+	return out
+}
+
+/*
+roundWithin
+Description:
+
+	Returns the integer nearest to x if x is within tol of it, and x otherwise.
+*/
+func roundWithin(x, tol float64) float64 {
+	nearest := math.Round(x)
+	switch {
+	case math.Abs(x-nearest) > tol:
+		return x
+	case nearest == 0:
+		return 0.0 // Avoid returning -0
+	default:
+		return nearest
+	}
+}
+
+/*
+Outer
+Description:
+
+	Computes the outer product of the vector with other, i.e. the matrix
+	whose (ii,jj) element is kv[ii] * other[jj].
+*/
+func (kv KVector) Outer(other VectorExpression) MatrixExpression {
+	return VectorOuterTemplate(kv, other)
+}
+
+/*
+Sum
+Description:
+
+	Adds together all of the elements of the vector.
+*/
+func (kv KVector) Sum() ScalarExpression {
+	return VectorSumTemplate(kv)
+}
@@ -2,9 +2,10 @@ package symbolic
 
 import "fmt"
 
-// ConstrSense represents if the constraint x <= y, x >= y, or x == y. For easy
-// integration with Gurobi, the senses have been encoding using a byte in
-// the same way Gurobi encodes the constraint senses.
+// ConstrSense represents if the constraint x <= y, x >= y, x == y, x < y or x > y.
+// For easy integration with Gurobi, the senses have been encoding using a byte in
+// the same way Gurobi encodes the constraint senses. (Gurobi has no strict
+// inequalities, so SenseLessThan and SenseGreaterThan use bytes of their own.)
 type ConstrSense byte
 
 // Different constraint senses conforming to Gurobi's encoding.
@@ -12,6 +13,8 @@ const (
 	SenseEqual            ConstrSense = '='
 	SenseLessThanEqual                = '<'
 	SenseGreaterThanEqual             = '>'
+	SenseLessThan         ConstrSense = 'l'
+	SenseGreaterThan      ConstrSense = 'g'
 )
 
 func (cs ConstrSense) String() string {
@@ -22,6 +25,10 @@ func (cs ConstrSense) String() string {
 		return "<="
 	case SenseGreaterThanEqual:
 		return ">="
+	case SenseLessThan:
+		return "<"
+	case SenseGreaterThan:
+		return ">"
 	default:
 		panic(fmt.Errorf("unexpected constraint sense!"))
 	}
@@ -41,7 +48,35 @@ func (cs ConstrSense) Check() error {
 		return nil
 	case SenseGreaterThanEqual:
 		return nil
+	case SenseLessThan:
+		return nil
+	case SenseGreaterThan:
+		return nil
 	default:
 		return fmt.Errorf("unexpected constraint sense: %v!", cs)
 	}
 }
+
+/*
+Reverse
+Description:
+
+	Returns the sense obtained by swapping the two sides of a constraint,
+	i.e., <= becomes >=, >= becomes <=, < becomes >, > becomes < and = stays =.
+*/
+func (cs ConstrSense) Reverse() ConstrSense {
+	switch cs {
+	case SenseLessThanEqual:
+		return SenseGreaterThanEqual
+	case SenseGreaterThanEqual:
+		return SenseLessThanEqual
+	case SenseLessThan:
+		return SenseGreaterThan
+	case SenseGreaterThan:
+		return SenseLessThan
+	case SenseEqual:
+		return SenseEqual
+	default:
+		panic(fmt.Errorf("unexpected constraint sense: %v!", cs))
+	}
+}
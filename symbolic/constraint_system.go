@@ -0,0 +1,79 @@
+package symbolic
+
+import "fmt"
+
+/*
+constraint_system.go
+Description:
+	Defines a container which collects the constraints of an optimization problem
+	(scalar, vector and matrix constraints) so that they can be handed to a solver
+	as a single object.
+*/
+
+type ConstraintSystem struct {
+	constraints []Constraint
+}
+
+/*
+AddConstraint
+Description:
+
+	Adds the constraint c to the system. Panics if c is not well-defined
+	(e.g., if the two sides of the constraint have different dimensions).
+*/
+func (cs *ConstraintSystem) AddConstraint(c Constraint) {
+	// Input Processing
+	if !IsConstraint(c) {
+		panic(
+			fmt.Errorf("AddConstraint: unexpected constraint type %T", c),
+		)
+	}
+
+	err := c.Check()
+	if err != nil {
+		panic(
+			fmt.Errorf("AddConstraint: constraint %v is not well-defined: %v", len(cs.constraints), err),
+		)
+	}
+
+	// Algorithm
+	cs.constraints = append(cs.constraints, c)
+}
+
+/*
+Constraints
+Description:
+
+	Returns the constraints in the system, in the order in which they were added.
+*/
+func (cs ConstraintSystem) Constraints() []Constraint {
+	out := make([]Constraint, len(cs.constraints))
+	copy(out, cs.constraints)
+	return out
+}
+
+/*
+Len
+Description:
+
+	Returns the number of constraints in the system.
+*/
+func (cs ConstraintSystem) Len() int {
+	return len(cs.constraints)
+}
+
+/*
+Variables
+Description:
+
+	Returns the unique variables that appear in any of the constraints
+	of the system.
+*/
+func (cs ConstraintSystem) Variables() []Variable {
+	var variables []Variable
+	for _, c := range cs.constraints {
+		variables = append(variables, c.Left().Variables()...)
+		variables = append(variables, c.Right().Variables()...)
+	}
+	return UniqueVars(variables)
+}
@@ -1,5 +1,7 @@
 package symbolic
 
+import "sync"
+
 /*
 environment.go
 Description:
@@ -9,6 +11,9 @@ Description:
 type Environment struct {
 	Name      string
 	Variables []Variable
+
+	// mu guards Variables, so that variables can be created concurrently.
+	mu sync.Mutex
 }
 
 /*
@@ -23,3 +28,85 @@ var BackgroundEnvironment = Environment{
 	Name:      "Background",
 	Variables: []Variable{},
 }
+
+/*
+addVariable
+Description:
+
+	Adds a new variable to the environment. The ID of the variable is the number of
+	variables already in the environment, and newVariable builds the variable from
+	that ID. The lock is held throughout, so concurrent calls always receive
+	unique IDs.
+*/
+func (env *Environment) addVariable(newVariable func(id uint64) Variable) Variable {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+
+	variableOut := newVariable(uint64(len(env.Variables)))
+	env.Variables = append(env.Variables, variableOut)
+
+	return variableOut
+}
+
+/*
+updateVariable
+Description:
+
+	Replaces the copy of the variable v stored in the environment (i.e., the
+	variable with the same ID) with v.
+*/
+func (env *Environment) updateVariable(v Variable) {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+
+	for ii, stored := range env.Variables {
+		if stored.ID == v.ID {
+			env.Variables[ii] = v
+			return
+		}
+	}
+}
+
+/*
+ResetVariableCounter
+Description:
+
+	Removes every variable from the BackgroundEnvironment, so that the next variable
+	created in it has ID 0 again. This is mostly useful for producing stable IDs in
+	tests. It is not safe to reset the counter while variables are being created
+	in other goroutines, and variables created before the reset will share IDs with
+	the variables created after it.
+*/
+func ResetVariableCounter() {
+	BackgroundEnvironment.mu.Lock()
+	defer BackgroundEnvironment.mu.Unlock()
+
+	BackgroundEnvironment.Variables = []Variable{}
+}
+
+/*
+WithVariableScope
+Description:
+
+	Runs f and then restores the variables of the BackgroundEnvironment (and thus
+	the counter used for new IDs) to what they were before f was called, even if
+	f panics. Like ResetVariableCounter, this is not safe to use while variables
+	are being created in other goroutines.
+*/
+func WithVariableScope(f func()) {
+	// Take a snapshot of the variables
+	BackgroundEnvironment.mu.Lock()
+	saved := make([]Variable, len(BackgroundEnvironment.Variables))
+	copy(saved, BackgroundEnvironment.Variables)
+	BackgroundEnvironment.mu.Unlock()
+
+	// Restore the snapshot once f is done
+	defer func() {
+		BackgroundEnvironment.mu.Lock()
+		defer BackgroundEnvironment.mu.Unlock()
+
+		BackgroundEnvironment.Variables = saved
+	}()
+
+	f()
+}
@@ -99,6 +99,127 @@ func VariableIDs(e Expression) []uint64 {
 	return idSlice
 }
 
+/*
+ContainsVariable
+Description:
+
+	Returns true if the variable v appears anywhere in the expression e.
+	Unlike Variables(), this stops scanning as soon as the variable is found.
+*/
+func ContainsVariable(e Expression, v Variable) bool {
+	// Algorithm
+	switch concreteE := e.(type) {
+	case K, KVector, KMatrix:
+		return false
+	case Variable:
+		return concreteE.ID == v.ID
+	case Monomial:
+		for _, factor := range concreteE.VariableFactors {
+			if factor.ID == v.ID {
+				return true
+			}
+		}
+		return false
+	case Polynomial:
+		for _, monomial := range concreteE.Monomials {
+			if ContainsVariable(monomial, v) {
+				return true
+			}
+		}
+		return false
+	case VariableVector, MonomialVector, PolynomialVector:
+		ve, _ := ToVectorExpression(concreteE)
+		for ii := 0; ii < ve.Len(); ii++ {
+			if ContainsVariable(ve.AtVec(ii), v) {
+				return true
+			}
+		}
+		return false
+	case VariableMatrix, MonomialMatrix, PolynomialMatrix:
+		nRows, nCols := concreteE.Dims()[0], concreteE.Dims()[1]
+		for ii := 0; ii < nRows; ii++ {
+			for jj := 0; jj < nCols; jj++ {
+				if ContainsVariable(concreteE.At(ii, jj), v) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	// For all other expressions, search through the list of variables
+	foundIndex, _ := FindInSlice(v, e.Variables())
+	return foundIndex != -1
+}
+
+/*
+Freeze
+Description:
+
+	Returns a deep-copied, simplified and canonicalized snapshot of the expression e.
+	The snapshot shares no slices with e, so later operations that mutate e (or the
+	snapshot) do not affect the other. Monomials whose coefficients are zero after
+	simplification are dropped.
+*/
+func Freeze(e Expression) Expression {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	switch concreteE := e.(type) {
+	case K, Variable, Monomial, Polynomial:
+		return freezeScalar(concreteE.(ScalarExpression))
+	case KVector, VariableVector, MonomialVector, PolynomialVector:
+		ve := concreteE.(VectorExpression)
+		var frozen []ScalarExpression
+		for ii := 0; ii < ve.Len(); ii++ {
+			frozen = append(frozen, freezeScalar(ve.AtVec(ii)))
+		}
+		return ConcretizeVectorExpression(frozen)
+	case KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix:
+		me := concreteE.(MatrixExpression)
+		var frozen [][]ScalarExpression
+		for ii := 0; ii < me.Dims()[0]; ii++ {
+			var frozenRow []ScalarExpression
+			for jj := 0; jj < me.Dims()[1]; jj++ {
+				frozenRow = append(frozenRow, freezeScalar(me.At(ii, jj)))
+			}
+			frozen = append(frozen, frozenRow)
+		}
+		return ConcretizeMatrixExpression(frozen)
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "Freeze",
+			Input:        e,
+		},
+	)
+}
+
+/*
+freezeScalar
+Description:
+
+	Creates the deep-copied, simplified and canonicalized snapshot of a single
+	scalar expression for Freeze.
+*/
+func freezeScalar(se ScalarExpression) ScalarExpression {
+	switch concreteSE := se.(type) {
+	case Monomial:
+		return concreteSE.Canonicalize()
+	case Polynomial:
+		// Simplify canonicalizes each monomial into new slices
+		return concreteSE.Simplify()
+	}
+
+	// K and Variable are values that contain no slices
+	return se
+}
+
 /*
 IsExpression
 Description:
@@ -280,6 +401,88 @@ func VStack(eIn ...Expression) Expression {
 	return ConcretizeExpression(result)
 }
 
+/*
+VStackAll
+Description:
+
+	Assembles a block matrix from a grid of expressions. Each element of parts
+	is a row of blocks which are stacked horizontally, and the resulting rows
+	are stacked vertically. This is equivalent to
+		VStack( HStack(parts[0]...), HStack(parts[1]...), ... )
+	but builds the full matrix in a single pass, without any intermediate
+	concretizations.
+*/
+func VStackAll(parts [][]Expression) Expression {
+	// Input Checking
+	if len(parts) == 0 {
+		panic(
+			fmt.Errorf("VStackAll: There must be at least one row of expressions in the input; received 0"),
+		)
+	}
+
+	for _, row := range parts {
+		for _, e := range row {
+			err := e.Check()
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// Check that the blocks in each row have the same number of rows and
+	// that every row of blocks has the same total number of columns
+	var nColsInRow []int
+	for ii, row := range parts {
+		if len(row) == 0 {
+			panic(
+				fmt.Errorf("VStackAll: There must be at least one expression in each row of the input; row %v has 0", ii),
+			)
+		}
+
+		var mlSlice []smErrors.MatrixLike
+		nCols := 0
+		for _, e := range row {
+			mlSlice = append(mlSlice, e)
+			nCols += e.Dims()[1]
+		}
+
+		err := smErrors.CheckDimensionsInHStack(mlSlice...)
+		if err != nil {
+			panic(err)
+		}
+
+		if ii > 0 && nCols != nColsInRow[0] {
+			panic(
+				fmt.Errorf(
+					"VStackAll: row %v of the input has %v columns, but row 0 has %v columns",
+					ii,
+					nCols,
+					nColsInRow[0],
+				),
+			)
+		}
+		nColsInRow = append(nColsInRow, nCols)
+	}
+
+	// Create the resulting Matrix's shape
+	var result [][]ScalarExpression
+	for _, row := range parts {
+		for rowIndex := 0; rowIndex < row[0].Dims()[0]; rowIndex++ {
+			tempRow := make([]ScalarExpression, 0, nColsInRow[0])
+			for _, e := range row {
+				for colIndex := 0; colIndex < e.Dims()[1]; colIndex++ {
+					tempRow = append(tempRow, e.At(rowIndex, colIndex))
+				}
+			}
+			// Add the row to the result
+			result = append(result, tempRow)
+		}
+	}
+
+	// Return the simplified form of the expression
+	return ConcretizeExpression(result)
+}
+
 /*
 ConcretizeExpression
 Description:
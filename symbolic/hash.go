@@ -0,0 +1,108 @@
+package symbolic
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"math"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+)
+
+/*
+hash.go
+Description:
+
+	Functions for hashing expressions, so that they can be used as the keys of
+	a map (e.g., when memoizing repeated symbolic computations).
+*/
+
+/*
+Hash
+Description:
+
+	Returns a hash of the expression e which does not depend on the order of the
+	monomials in a polynomial or of the variable factors in a monomial.
+	Scalar expressions are hashed as polynomials, so x and x.ToPolynomial() share a
+	hash, while vectors and matrices also hash their dimensions.
+	Coefficients are rounded to multiples of coefficientTolerance before they are
+	hashed, so polynomials which are equal according to Polynomial.Equals
+	(e.g., 0.1 x + 0.2 x and 0.3 x) share a hash, while x and 2 x do not.
+*/
+func Hash(e Expression) uint64 {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	h := fnv.New64a()
+	switch {
+	case IsScalarExpression(e):
+		writeHashUint64(h, 0)
+		writeHashScalar(h, e.(ScalarExpression))
+	case IsVectorExpression(e):
+		ve := e.(VectorExpression)
+		writeHashUint64(h, 1)
+		writeHashUint64(h, uint64(ve.Len()))
+		for ii := 0; ii < ve.Len(); ii++ {
+			writeHashScalar(h, ve.AtVec(ii))
+		}
+	case IsMatrixExpression(e):
+		me := e.(MatrixExpression)
+		writeHashUint64(h, 2)
+		writeHashUint64(h, uint64(me.Dims()[0]))
+		writeHashUint64(h, uint64(me.Dims()[1]))
+		for ii := 0; ii < me.Dims()[0]; ii++ {
+			for jj := 0; jj < me.Dims()[1]; jj++ {
+				writeHashScalar(h, me.At(ii, jj))
+			}
+		}
+	default:
+		panic(
+			smErrors.UnsupportedInputError{
+				FunctionName: "Hash",
+				Input:        e,
+			},
+		)
+	}
+
+	return h.Sum64()
+}
+
+/*
+writeHashScalar
+Description:
+
+	Writes the canonical form of the scalar expression se to h, with each
+	coefficient rounded to a multiple of coefficientTolerance.
+*/
+func writeHashScalar(h hash.Hash64, se ScalarExpression) {
+	// Constants
+	p, _ := toPolynomial(se)
+
+	// Algorithm
+	p = p.canonical()
+	writeHashUint64(h, uint64(len(p.Monomials)))
+	for _, monomial := range p.Monomials {
+		writeHashUint64(h, uint64(int64(math.Round(monomial.Coefficient/coefficientTolerance))))
+		writeHashUint64(h, uint64(len(monomial.VariableFactors)))
+		for ii, v := range monomial.VariableFactors {
+			writeHashUint64(h, v.ID)
+			writeHashUint64(h, uint64(monomial.Exponents[ii]))
+		}
+	}
+}
+
+/*
+writeHashUint64
+Description:
+
+	Writes the 8 bytes of x to h.
+*/
+func writeHashUint64(h hash.Hash64, x uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], x)
+	h.Write(buf[:])
+}
@@ -0,0 +1,166 @@
+package symbolic
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+)
+
+/*
+latex.go
+Description:
+
+	Functions for rendering expressions as LaTeX strings.
+	Variables are rendered as x_{id}, exponents as ^{k} and vectors/matrices
+	inside of a bmatrix environment.
+*/
+
+/*
+LaTeX
+Description:
+
+	Renders the expression e as a LaTeX string.
+*/
+func LaTeX(e Expression) string {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	switch concreteE := e.(type) {
+	case K, Variable, Monomial, Polynomial:
+		return latexScalar(concreteE.(ScalarExpression))
+	case KVector, VariableVector, MonomialVector, PolynomialVector:
+		ve := concreteE.(VectorExpression)
+		var rows []string
+		for ii := 0; ii < ve.Len(); ii++ {
+			rows = append(rows, latexScalar(ve.AtVec(ii)))
+		}
+		return latexBMatrix(rows)
+	case KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix:
+		me := concreteE.(MatrixExpression)
+		var rows []string
+		for ii := 0; ii < me.Dims()[0]; ii++ {
+			var entries []string
+			for jj := 0; jj < me.Dims()[1]; jj++ {
+				entries = append(entries, latexScalar(me.At(ii, jj)))
+			}
+			rows = append(rows, strings.Join(entries, " & "))
+		}
+		return latexBMatrix(rows)
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "LaTeX",
+			Input:        e,
+		},
+	)
+}
+
+/*
+latexScalar
+Description:
+
+	Renders a single scalar expression as a LaTeX string.
+*/
+func latexScalar(se ScalarExpression) string {
+	switch concreteSE := se.(type) {
+	case K:
+		return latexNumber(float64(concreteSE))
+	case Variable:
+		return fmt.Sprintf("x_{%v}", concreteSE.ID)
+	case Monomial:
+		return latexMonomial(concreteSE)
+	case Polynomial:
+		var out string
+		for ii, monomial := range concreteSE.Monomials {
+			switch {
+			case ii == 0:
+				out = latexMonomial(monomial)
+			case monomial.Coefficient < 0:
+				negated := monomial.Copy()
+				negated.Coefficient = -negated.Coefficient
+				out += " - " + latexMonomial(negated)
+			default:
+				out += " + " + latexMonomial(monomial)
+			}
+		}
+		return out
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "latexScalar",
+			Input:        se,
+		},
+	)
+}
+
+/*
+latexMonomial
+Description:
+
+	Renders a monomial as a LaTeX string. A coefficient of 1 (or -1) is omitted
+	unless the monomial is constant.
+*/
+func latexMonomial(m Monomial) string {
+	// Collect the variable factors
+	var factors []string
+	for ii, v := range m.VariableFactors {
+		switch m.Exponents[ii] {
+		case 0:
+			continue
+		case 1:
+			factors = append(factors, latexScalar(v))
+		default:
+			factors = append(factors, fmt.Sprintf("%v^{%v}", latexScalar(v), m.Exponents[ii]))
+		}
+	}
+
+	if len(factors) == 0 {
+		return latexNumber(m.Coefficient)
+	}
+
+	// Create the coefficient
+	switch m.Coefficient {
+	case 1.0:
+		return strings.Join(factors, " ")
+	case -1.0:
+		return "-" + strings.Join(factors, " ")
+	default:
+		return latexNumber(m.Coefficient) + " " + strings.Join(factors, " ")
+	}
+}
+
+/*
+latexNumber
+Description:
+
+	Renders a float64 in the shortest form that represents it exactly.
+*/
+func latexNumber(x float64) string {
+	if math.IsInf(x, 0) {
+		if x < 0 {
+			return "-\\infty"
+		}
+		return "\\infty"
+	}
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+/*
+latexBMatrix
+Description:
+
+	Wraps the given rows (whose entries are already separated by &) in a
+	bmatrix environment.
+*/
+func latexBMatrix(rows []string) string {
+	return "\\begin{bmatrix} " + strings.Join(rows, " \\\\ ") + " \\end{bmatrix}"
+}
@@ -0,0 +1,187 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+linear_model.go
+Description:
+
+	Functions for exporting a linear program (a linear objective and a set of
+	linear constraints) in the standard matrix form
+		minimize c^T x subject to A x {<=, =, >=} b
+	over a fixed ordering of the variables.
+*/
+
+/*
+ExtractLinearModel
+Description:
+
+	Assembles the linear program defined by objective and constraints into matrix
+	form over the variable ordering vars. Each scalar element of each constraint
+	becomes one row of A, with the matching entries of senses and b.
+	An error is returned if the objective or any constraint is nonlinear, if it
+	contains a variable that is not in vars, or if a constraint uses a strict
+	sense (< or >), which a linear program cannot represent.
+	The constant part of the objective is not part of the model.
+*/
+func ExtractLinearModel(objective ScalarExpression, constraints []Constraint, vars []Variable) (c mat.VecDense, A mat.Dense, senses []ConstrSense, b mat.VecDense, err error) {
+	// Input Processing
+	err = objective.Check()
+	if err != nil {
+		return c, A, senses, b, err
+	}
+
+	if len(vars) == 0 {
+		return c, A, senses, b, fmt.Errorf("ExtractLinearModel: there must be at least one variable in the model; received 0")
+	}
+
+	for _, v := range vars {
+		err = v.Check()
+		if err != nil {
+			return c, A, senses, b, err
+		}
+	}
+
+	// Collect the objective coefficients
+	objectiveAsP, _ := toPolynomial(objective)
+	cValues, _, err := linearRowOf(objectiveAsP, vars)
+	if err != nil {
+		return c, A, senses, b, fmt.Errorf("ExtractLinearModel: objective: %v", err)
+	}
+
+	// Split the constraints into scalar constraints
+	var scalarConstraints []ScalarConstraint
+	for ii, constraint := range constraints {
+		err = constraint.Check()
+		if err != nil {
+			return c, A, senses, b, fmt.Errorf("ExtractLinearModel: constraint %v: %v", ii, err)
+		}
+
+		scalarConstraints = append(scalarConstraints, scalarConstraintsOf(constraint)...)
+	}
+
+	// Create one row for each scalar constraint
+	var aValues, bValues []float64
+	for ii, sc := range scalarConstraints {
+		switch sc.Sense {
+		case SenseLessThanEqual, SenseGreaterThanEqual, SenseEqual:
+		default:
+			return c, A, senses, b, fmt.Errorf(
+				"ExtractLinearModel: constraint row %v: sense %v is not supported; only <=, >= and = are allowed",
+				ii, sc.Sense,
+			)
+		}
+
+		// Move everything to the left hand side
+		leftAsP, _ := toPolynomial(sc.LeftHandSide)
+		difference, _ := toPolynomial(leftAsP.Minus(sc.RightHandSide))
+
+		row, constant, err := linearRowOf(difference, vars)
+		if err != nil {
+			return c, A, senses, b, fmt.Errorf("ExtractLinearModel: constraint row %v: %v", ii, err)
+		}
+
+		aValues = append(aValues, row...)
+		bValues = append(bValues, -constant)
+		senses = append(senses, sc.Sense)
+	}
+
+	// Assemble the outputs
+	c = *mat.NewVecDense(len(vars), cValues)
+	if len(scalarConstraints) > 0 {
+		A = *mat.NewDense(len(scalarConstraints), len(vars), aValues)
+		b = *mat.NewVecDense(len(scalarConstraints), bValues)
+	}
+
+	return c, A, senses, b, nil
+}
+
+/*
+scalarConstraintsOf
+Description:
+
+	Splits the constraint into the scalar constraints formed by each of its
+	elements (in row-major order for matrix constraints).
+*/
+func scalarConstraintsOf(constraint Constraint) []ScalarConstraint {
+	switch concreteC := constraint.(type) {
+	case ScalarConstraint:
+		return []ScalarConstraint{concreteC}
+	case *ScalarConstraint:
+		return []ScalarConstraint{*concreteC}
+	case VectorConstraint:
+		return concreteC.ToScalarConstraints()
+	case *VectorConstraint:
+		return scalarConstraintsOf(*concreteC)
+	case MatrixConstraint:
+		var out []ScalarConstraint
+		for ii := 0; ii < concreteC.Dims()[0]; ii++ {
+			for jj := 0; jj < concreteC.Dims()[1]; jj++ {
+				out = append(out, concreteC.At(ii, jj))
+			}
+		}
+		return out
+	case *MatrixConstraint:
+		return scalarConstraintsOf(*concreteC)
+	}
+
+	panic(
+		fmt.Errorf("scalarConstraintsOf: unexpected constraint type %T", constraint),
+	)
+}
+
+/*
+linearRowOf
+Description:
+
+	Returns the coefficients of each variable in vars for the polynomial p along with
+	its constant term. An error is returned if p has a monomial of degree greater than
+	one or if it contains a variable that is not in vars.
+*/
+func linearRowOf(p Polynomial, vars []Variable) ([]float64, float64, error) {
+	// Constants
+	row := make([]float64, len(vars))
+	constant := 0.0
+
+	// Algorithm
+	for _, monomial := range p.Monomials {
+		switch {
+		case monomial.IsConstant():
+			constant += monomial.Coefficient
+		case monomial.Degree() == 1:
+			// Find the (only) factor with a nonzero exponent
+			var factor Variable
+			for ii, v := range monomial.VariableFactors {
+				if monomial.Exponents[ii] != 0 {
+					factor = v
+				}
+			}
+
+			vIndex := -1
+			for ii, v := range vars {
+				if v.ID == factor.ID {
+					vIndex = ii
+					break
+				}
+			}
+			if vIndex == -1 {
+				return nil, 0.0, fmt.Errorf(
+					"variable %v is not in the list of model variables",
+					factor,
+				)
+			}
+			row[vIndex] += monomial.Coefficient
+		default:
+			return nil, 0.0, fmt.Errorf(
+				"monomial %v has degree %v; only linear expressions are supported",
+				monomial, monomial.Degree(),
+			)
+		}
+	}
+
+	return row, constant, nil
+}
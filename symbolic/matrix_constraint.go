@@ -145,3 +145,37 @@ Description:
 func (mc MatrixConstraint) IsLinear() bool {
 	return IsLinear(mc.RightHandSide) && IsLinear(mc.LeftHandSide)
 }
+
+/*
+Flatten
+Description:
+
+	Converts the matrix constraint into a vector constraint with the same sense
+	by vectorizing both sides column-major, so that element (ii,jj) of the matrix
+	constraint becomes element jj*nRows + ii of the vector constraint.
+*/
+func (mc MatrixConstraint) Flatten() VectorConstraint {
+	// Input Processing
+	err := mc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Constants
+	nR, nC := mc.Dims()[0], mc.Dims()[1]
+
+	// Algorithm
+	var lhsElements, rhsElements []ScalarExpression
+	for jj := 0; jj < nC; jj++ {
+		for ii := 0; ii < nR; ii++ {
+			lhsElements = append(lhsElements, mc.LeftHandSide.At(ii, jj))
+			rhsElements = append(rhsElements, mc.RightHandSide.At(ii, jj))
+		}
+	}
+
+	return VectorConstraint{
+		LeftHandSide:  ConcretizeVectorExpression(lhsElements),
+		RightHandSide: ConcretizeVectorExpression(rhsElements),
+		Sense:         mc.Sense,
+	}
+}
@@ -190,6 +190,186 @@ func MatrixPowerTemplate(me MatrixExpression, exponent int) MatrixExpression {
 	return out
 }
 
+/*
+MatrixRowTemplate
+Description:
+
+	Defines the template for extracting row ii of a matrix expression as a
+	(concretized) vector expression.
+*/
+func MatrixRowTemplate(me MatrixExpression, ii int) VectorExpression {
+	// Input Processing
+	err := me.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = smErrors.CheckIndexOnMatrix(ii, 0, me)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var row []ScalarExpression
+	for jj := 0; jj < me.Dims()[1]; jj++ {
+		row = append(row, me.At(ii, jj))
+	}
+
+	return ConcretizeVectorExpression(row)
+}
+
+/*
+MatrixColumnTemplate
+Description:
+
+	Defines the template for extracting column jj of a matrix expression as a
+	(concretized) vector expression.
+*/
+func MatrixColumnTemplate(me MatrixExpression, jj int) VectorExpression {
+	// Input Processing
+	err := me.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = smErrors.CheckIndexOnMatrix(0, jj, me)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var column []ScalarExpression
+	for ii := 0; ii < me.Dims()[0]; ii++ {
+		column = append(column, me.At(ii, jj))
+	}
+
+	return ConcretizeVectorExpression(column)
+}
+
+/*
+checkSubMatrixRange
+Description:
+
+	Verifies that the half-open ranges [rowStart, rowEnd) and [colStart, colEnd)
+	are non-empty ranges of valid row and column indices of the matrix me.
+*/
+func checkSubMatrixRange(rowStart, rowEnd, colStart, colEnd int, me MatrixExpression) error {
+	if rowEnd <= rowStart || colEnd <= colStart {
+		return fmt.Errorf(
+			"the submatrix range [%v, %v) x [%v, %v) is empty or inverted; expected start < end",
+			rowStart, rowEnd, colStart, colEnd,
+		)
+	}
+
+	err := smErrors.CheckIndexOnMatrix(rowStart, colStart, me)
+	if err != nil {
+		return err
+	}
+
+	return smErrors.CheckIndexOnMatrix(rowEnd-1, colEnd-1, me)
+}
+
+/*
+MatrixSumTemplate
+Description:
+
+	Defines the template for adding together all of the elements of a
+	matrix expression. The result is concretized, so a sum of constants is a K.
+*/
+func MatrixSumTemplate(me MatrixExpression) ScalarExpression {
+	// Input Processing
+	err := me.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if me.Dims()[0] == 0 || me.Dims()[1] == 0 {
+		panic(smErrors.EmptyMatrixError{Expression: me})
+	}
+
+	// Algorithm
+	var sum Expression = me.At(0, 0)
+	for ii := 0; ii < me.Dims()[0]; ii++ {
+		for jj := 0; jj < me.Dims()[1]; jj++ {
+			if ii == 0 && jj == 0 {
+				continue
+			}
+			sum = sum.Plus(me.At(ii, jj))
+		}
+	}
+
+	return collapseConstant(sum.(ScalarExpression))
+}
+
+/*
+MatrixSubstituteAccordingToTemplate
+Description:
+
+	Defines the template for substituting the variables in a matrix expression
+	according to subMap. Each element is substituted on its own (with all of the
+	variables replaced at once), so the result does not depend on the order of
+	the map. The result is concretized.
+*/
+func MatrixSubstituteAccordingToTemplate(me MatrixExpression, subMap map[Variable]Expression) MatrixExpression {
+	// Input Processing
+	err := me.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = CheckSubstitutionMap(subMap)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var elements [][]ScalarExpression
+	for ii := 0; ii < me.Dims()[0]; ii++ {
+		var row []ScalarExpression
+		for jj := 0; jj < me.Dims()[1]; jj++ {
+			row = append(
+				row,
+				me.At(ii, jj).SubstituteAccordingTo(subMap).(ScalarExpression),
+			)
+		}
+		elements = append(elements, row)
+	}
+
+	return ConcretizeMatrixExpression(elements)
+}
+
+/*
+MatrixElementWisePowerTemplate
+Description:
+
+	Defines the template for raising each element of a matrix expression to
+	the power of the input integer. The result is a matrix of the same shape;
+	an exponent of 0 gives a matrix of ones.
+*/
+func MatrixElementWisePowerTemplate(base MatrixExpression, exponent int) MatrixExpression {
+	// Input Processing
+	err := base.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if exponent < 0 {
+		panic(smErrors.NegativeExponentError{Exponent: exponent})
+	}
+
+	// Algorithm
+	var result [][]ScalarExpression
+	for ii := 0; ii < base.Dims()[0]; ii++ {
+		var tempRow []ScalarExpression
+		for jj := 0; jj < base.Dims()[1]; jj++ {
+			tempRow = append(tempRow, base.At(ii, jj).Power(exponent).(ScalarExpression))
+		}
+		result = append(result, tempRow)
+	}
+
+	return ConcretizeMatrixExpression(result)
+}
+
 /*
 MatrixSubstituteTemplate
 Description:
@@ -219,7 +399,7 @@ func MatrixSubstituteTemplate(me MatrixExpression, vIn Variable, seIn ScalarExpr
 		var tempRow []ScalarExpression
 		for jj := 0; jj < me.Dims()[1]; jj++ {
 			newElt := me.At(ii, jj).Substitute(vIn, seIn)
-			tempRow = append(tempRow, newElt.(ScalarExpression))
+			tempRow = append(tempRow, collapseConstant(newElt.(ScalarExpression)))
 		}
 		out = append(out, tempRow)
 	}
@@ -416,3 +596,461 @@ func ConcretizeMatrixExpression(sliceIn [][]ScalarExpression) MatrixExpression {
 		)
 	}
 }
+
+/*
+BlockDiagonal
+Description:
+
+	Creates a block-diagonal matrix from the given blocks. Each block is placed
+	on the diagonal (in order) and all off-diagonal regions are filled with zeros.
+	The resulting matrix has as many rows (columns) as the sum of the rows (columns)
+	of all of the blocks.
+*/
+func BlockDiagonal(blocks ...MatrixExpression) MatrixExpression {
+	// Input Processing
+	if len(blocks) == 0 {
+		panic(
+			fmt.Errorf("BlockDiagonal: There must be at least one block in the input; received 0"),
+		)
+	}
+
+	for _, block := range blocks {
+		err := block.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Setup
+	nRows, nCols := 0, 0
+	for _, block := range blocks {
+		nRows += block.Dims()[0]
+		nCols += block.Dims()[1]
+	}
+
+	// Create a matrix of zeros with the final shape
+	var result [][]ScalarExpression
+	for ii := 0; ii < nRows; ii++ {
+		var tempRow []ScalarExpression
+		for jj := 0; jj < nCols; jj++ {
+			tempRow = append(tempRow, K(0.0))
+		}
+		result = append(result, tempRow)
+	}
+
+	// Place each block on the diagonal
+	rowOffset, colOffset := 0, 0
+	for _, block := range blocks {
+		nRows_b, nCols_b := block.Dims()[0], block.Dims()[1]
+		for ii := 0; ii < nRows_b; ii++ {
+			for jj := 0; jj < nCols_b; jj++ {
+				result[rowOffset+ii][colOffset+jj] = block.At(ii, jj)
+			}
+		}
+		rowOffset += nRows_b
+		colOffset += nCols_b
+	}
+
+	return ConcretizeMatrixExpression(result)
+}
+
+/*
+MatricesEqual
+Description:
+
+	Determines whether or not the two matrix expressions are equal, up to the
+	tolerance tol. The dimensions are compared first (returning false on a mismatch)
+	and then each entry is compared using AreEqual.
+*/
+func MatricesEqual(a, b MatrixExpression, tol float64) bool {
+	// Input Processing
+	err := a.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = b.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Compare dimensions
+	if a.Dims()[0] != b.Dims()[0] || a.Dims()[1] != b.Dims()[1] {
+		return false
+	}
+
+	// Algorithm
+	nRows, nCols := a.Dims()[0], a.Dims()[1]
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols; jj++ {
+			if !AreEqual(a.At(ii, jj), b.At(ii, jj), tol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+/*
+VandermondeMatrix
+Description:
+
+	Creates the Vandermonde matrix for the variable vector v, whose (i,j) entry
+	is v[i]^j for j = 0, 1, ..., degree. The result has v.Len() rows
+	and degree + 1 columns.
+*/
+func VandermondeMatrix(v VariableVector, degree int) MatrixExpression {
+	// Input Processing
+	err := v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if degree < 0 {
+		panic(smErrors.NegativeExponentError{Exponent: degree})
+	}
+
+	// Algorithm
+	var result [][]ScalarExpression
+	for _, vII := range v {
+		var tempRow []ScalarExpression
+		for jj := 0; jj <= degree; jj++ {
+			tempRow = append(tempRow, vII.Power(jj).(ScalarExpression))
+		}
+		result = append(result, tempRow)
+	}
+
+	return ConcretizeMatrixExpression(result)
+}
+
+/*
+FrobeniusInnerProduct
+Description:
+
+	Computes the Frobenius inner product of the two matrix expressions, i.e.
+	sum_{i,j} a[i][j] * b[i][j]. The two matrices must have the same shape.
+	A constant result is returned as a K.
+*/
+func FrobeniusInnerProduct(a, b MatrixExpression) ScalarExpression {
+	// Input Processing
+	err := a.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = b.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if (a.Dims()[0] != b.Dims()[0]) || (a.Dims()[1] != b.Dims()[1]) {
+		panic(
+			smErrors.DimensionError{
+				Operation: "FrobeniusInnerProduct",
+				Arg1:      a,
+				Arg2:      b,
+			},
+		)
+	}
+
+	// Algorithm
+	result := K(0.0).ToPolynomial()
+	nRows, nCols := a.Dims()[0], a.Dims()[1]
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols; jj++ {
+			aIJ, _ := toPolynomial(a.At(ii, jj))
+			result = result.Plus(aIJ.Multiply(b.At(ii, jj))).(Polynomial)
+		}
+	}
+
+	result = result.Simplify()
+	if result.IsConstant() {
+		return K(result.Constant())
+	}
+
+	return result
+}
+
+/*
+EvalMatrix
+Description:
+
+	Evaluates each element of the matrix expression me according to the values
+	in assignment. An error is returned if any variable of me is missing
+	from assignment.
+*/
+func EvalMatrix(me MatrixExpression, assignment map[Variable]float64) (mat.Dense, error) {
+	// Input Processing
+	err := me.Check()
+	if err != nil {
+		return mat.Dense{}, err
+	}
+
+	// Algorithm
+	nRows, nCols := me.Dims()[0], me.Dims()[1]
+	values := make([]float64, nRows*nCols)
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols; jj++ {
+			values[ii*nCols+jj], err = me.At(ii, jj).Eval(assignment)
+			if err != nil {
+				return mat.Dense{}, err
+			}
+		}
+	}
+
+	return *mat.NewDense(nRows, nCols, values), nil
+}
+
+/*
+GramMatrix
+Description:
+
+	Computes the Gram matrix of the vector expression ve, i.e., the symmetric
+	matrix whose (i,j) entry is ve.AtVec(i) * ve.AtVec(j). For a VariableVector,
+	this is a MonomialMatrix of quadratic terms.
+*/
+func GramMatrix(ve VectorExpression) MatrixExpression {
+	// Input Processing
+	err := ve.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if ve.Len() == 0 {
+		panic(smErrors.EmptyVectorError{Expression: ve})
+	}
+
+	// Constants
+	n := ve.Len()
+
+	// Algorithm
+	result := make([][]ScalarExpression, n)
+	for ii := range result {
+		result[ii] = make([]ScalarExpression, n)
+	}
+
+	// Compute each product in the same order (ve[min(i,j)] * ve[max(i,j)])
+	// so that the result is exactly symmetric
+	for ii := 0; ii < n; ii++ {
+		for jj := ii; jj < n; jj++ {
+			product := ve.AtVec(ii).Multiply(ve.AtVec(jj)).(ScalarExpression)
+			result[ii][jj] = product
+
+			// Give the mirrored entry its own copy so that the two do not share memory
+			switch concreteProduct := product.(type) {
+			case Monomial:
+				result[jj][ii] = concreteProduct.Copy()
+			case Polynomial:
+				result[jj][ii] = concreteProduct.Copy()
+			default:
+				result[jj][ii] = product
+			}
+		}
+	}
+
+	return ConcretizeMatrixExpression(result)
+}
+
+/*
+Trace
+Description:
+
+	Computes the trace of the square matrix expression me, i.e., the sum of its
+	diagonal entries. The result is a K when the sum is constant (e.g., for a
+	KMatrix) and a Polynomial otherwise.
+*/
+func Trace(me MatrixExpression) ScalarExpression {
+	// Input Processing
+	err := me.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if !IsSquare(me) {
+		panic(
+			fmt.Errorf(
+				"matrix is not square (dimensions %v); cannot compute trace",
+				me.Dims(),
+			),
+		)
+	}
+
+	// Algorithm
+	result := K(0.0).ToPolynomial()
+	for ii := 0; ii < me.Dims()[0]; ii++ {
+		result = result.Plus(me.At(ii, ii)).(Polynomial)
+	}
+
+	result = result.Simplify()
+	if result.IsConstant() {
+		return K(result.Constant())
+	}
+
+	return result
+}
+
+/*
+Diag
+Description:
+
+	Creates the square matrix expression with the elements of the vector
+	expression v on its diagonal and zeros everywhere else. For example, a
+	KVector gives a KMatrix and a VariableVector gives a MonomialMatrix.
+*/
+func Diag(v VectorExpression) MatrixExpression {
+	// Input Processing
+	err := v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if v.Len() == 0 {
+		panic(smErrors.EmptyVectorError{Expression: v})
+	}
+
+	// Constants
+	n := v.Len()
+
+	// Algorithm
+	var result [][]ScalarExpression
+	for ii := 0; ii < n; ii++ {
+		tempRow := make([]ScalarExpression, n)
+		for jj := 0; jj < n; jj++ {
+			tempRow[jj] = K(0.0)
+		}
+		tempRow[ii] = v.AtVec(ii)
+		result = append(result, tempRow)
+	}
+
+	return ConcretizeMatrixExpression(result)
+}
+
+/*
+Diagonal
+Description:
+
+	Extracts the diagonal of the square matrix expression me as a vector
+	expression. This is the inverse of Diag.
+*/
+func Diagonal(me MatrixExpression) VectorExpression {
+	// Input Processing
+	err := me.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if !IsSquare(me) {
+		panic(
+			fmt.Errorf(
+				"matrix is not square (dimensions %v); cannot extract diagonal",
+				me.Dims(),
+			),
+		)
+	}
+
+	// Algorithm
+	var diagonal []ScalarExpression
+	for ii := 0; ii < me.Dims()[0]; ii++ {
+		diagonal = append(diagonal, me.At(ii, ii))
+	}
+
+	return ConcretizeVectorExpression(diagonal)
+}
+
+/*
+MaxDeterminantDim is the largest dimension of matrix for which Determinant will
+perform a cofactor expansion. (The cost of the expansion grows factorially.)
+*/
+const MaxDeterminantDim = 6
+
+/*
+Determinant
+Description:
+
+	Computes the determinant of the square matrix expression me using cofactor
+	(Laplace) expansion along the first row. The result is a K when the
+	determinant is constant (e.g., for a KMatrix) and a Polynomial otherwise.
+	Panics if me is not square or is larger than MaxDeterminantDim x MaxDeterminantDim.
+*/
+func Determinant(me MatrixExpression) ScalarExpression {
+	// Input Processing
+	err := me.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if !IsSquare(me) {
+		panic(
+			fmt.Errorf(
+				"matrix is not square (dimensions %v); cannot compute determinant",
+				me.Dims(),
+			),
+		)
+	}
+
+	if me.Dims()[0] > MaxDeterminantDim {
+		panic(
+			fmt.Errorf(
+				"matrix has dimension %v, but Determinant only supports matrices up to %v x %v (cofactor expansion is too expensive beyond that)",
+				me.Dims()[0], MaxDeterminantDim, MaxDeterminantDim,
+			),
+		)
+	}
+
+	// Algorithm
+	var entries [][]Polynomial
+	for ii := 0; ii < me.Dims()[0]; ii++ {
+		var tempRow []Polynomial
+		for jj := 0; jj < me.Dims()[1]; jj++ {
+			entryAsP, _ := toPolynomial(me.At(ii, jj))
+			tempRow = append(tempRow, entryAsP)
+		}
+		entries = append(entries, tempRow)
+	}
+
+	return collapseConstant(cofactorExpansion(entries).Simplify())
+}
+
+/*
+cofactorExpansion
+Description:
+
+	Computes the determinant of the square matrix of polynomials entries by
+	expanding along its first row.
+*/
+func cofactorExpansion(entries [][]Polynomial) Polynomial {
+	// Constants
+	n := len(entries)
+
+	// Base Cases
+	switch n {
+	case 1:
+		return entries[0][0]
+	case 2:
+		ad := entries[0][0].Multiply(entries[1][1]).(Polynomial)
+		bc := entries[0][1].Multiply(entries[1][0]).(Polynomial)
+		return ad.Plus(bc.Multiply(-1.0)).(Polynomial)
+	}
+
+	// Algorithm
+	det := K(0.0).ToPolynomial()
+	for jj := 0; jj < n; jj++ {
+		// Create the minor which removes row 0 and column jj
+		var minor [][]Polynomial
+		for ii := 1; ii < n; ii++ {
+			var tempRow []Polynomial
+			tempRow = append(tempRow, entries[ii][:jj]...)
+			tempRow = append(tempRow, entries[ii][jj+1:]...)
+			minor = append(minor, tempRow)
+		}
+
+		term := entries[0][jj].Multiply(cofactorExpansion(minor)).(Polynomial)
+		if jj%2 == 1 {
+			term = term.Multiply(-1.0).(Polynomial)
+		}
+		det = det.Plus(term).(Polynomial)
+	}
+
+	return det
+}
@@ -2,6 +2,9 @@ package symbolic
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
@@ -29,6 +32,38 @@ Description:
 	This function checks that the monomial is valid.
 */
 func (m Monomial) Check() error {
+	// Check the exponents
+	err := m.checkExponents()
+	if err != nil {
+		return err
+	}
+
+	// Check that no variable appears more than once
+	for ii, factor := range m.VariableFactors {
+		for jj := ii + 1; jj < len(m.VariableFactors); jj++ {
+			if factor.ID == m.VariableFactors[jj].ID {
+				return fmt.Errorf(
+					"variable %v appears more than once in the monomial (as factors %v and %v)",
+					factor,
+					ii,
+					jj,
+				)
+			}
+		}
+	}
+
+	// All Checks passed
+	return nil
+}
+
+/*
+checkExponents
+Description:
+
+	Checks that the monomial has one exponent for each variable factor and
+	that none of the exponents are negative.
+*/
+func (m Monomial) checkExponents() error {
 	// Check that the number of degrees matches the number of variables
 	if len(m.Exponents) != len(m.VariableFactors) {
 		return fmt.Errorf(
@@ -37,7 +72,19 @@ func (m Monomial) Check() error {
 			len(m.VariableFactors),
 		)
 	}
-	// All Checks passed
+
+	// Check that none of the exponents are negative
+	for ii, exponent := range m.Exponents {
+		if exponent < 0 {
+			return fmt.Errorf(
+				"exponent %v (for variable %v) is negative (%v); monomials only support non-negative exponents",
+				ii,
+				m.VariableFactors[ii],
+				exponent,
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -45,10 +92,10 @@ func (m Monomial) Check() error {
 Variables
 Description:
 
-	Returns the variables in the monomial.
+	Returns the variables in the monomial (sorted by ID, like UniqueVars).
 */
 func (m Monomial) Variables() []Variable {
-	return m.VariableFactors
+	return UniqueVars(m.VariableFactors)
 }
 
 /*
@@ -65,7 +112,9 @@ func (m Monomial) Dims() []int {
 Plus
 Description:
 
-	Multiplication of the monomial with another expression.
+	Addition of the monomial with another expression. When the monomial is added
+	to a monomial with the same variables and exponents, the two terms are combined
+	into a single monomial, which is returned as a Polynomial.
 */
 func (m Monomial) Plus(e interface{}) Expression {
 	// Input Processing
@@ -102,9 +151,11 @@ func (m Monomial) Plus(e interface{}) Expression {
 		}
 	case Variable:
 		if m.IsVariable(right) {
-			mCopy := m
+			mCopy := m.Copy()
 			mCopy.Coefficient += 1.0
-			return mCopy
+			return Polynomial{
+				Monomials: []Monomial{mCopy},
+			}
 		} else {
 			return Polynomial{
 				Monomials: []Monomial{m, right.ToMonomial()},
@@ -112,9 +163,12 @@ func (m Monomial) Plus(e interface{}) Expression {
 		}
 	case Monomial:
 		if m.MatchesFormOf(right) {
+			// Combine the two terms into a single monomial
 			monomialOut := m.Copy()
 			monomialOut.Coefficient += right.Coefficient
-			return monomialOut
+			return Polynomial{
+				Monomials: []Monomial{monomialOut},
+			}
 		} else {
 			return Polynomial{
 				Monomials: []Monomial{m, right},
@@ -219,7 +273,10 @@ func (m Monomial) Multiply(e interface{}) Expression {
 	case Monomial:
 
 		// Collect all variables in both monomials
-		variables := append(m.VariableFactors, right.VariableFactors...)
+		// (in a new slice, so that m's factors are never modified)
+		variables := make([]Variable, 0, len(m.VariableFactors)+len(right.VariableFactors))
+		variables = append(variables, m.VariableFactors...)
+		variables = append(variables, right.VariableFactors...)
 		variables = UniqueVars(variables)
 
 		multiDegree := make([]int, len(variables))
@@ -501,7 +558,7 @@ func (m Monomial) MatchesFormOf(mIn Monomial) bool {
 			return false
 		} else {
 			// If v was in mIn, but not of the right degree, then these two are not the same
-			if m.Exponents[ii] != mIn.Exponents[ii] {
+			if m.Exponents[ii] != mIn.Exponents[foundIndex] {
 				return false
 			}
 		}
@@ -558,7 +615,7 @@ func (m Monomial) DerivativeWrt(vIn Variable) Expression {
 				}
 			}
 		default:
-			monomialOut = m
+			monomialOut = m.Copy()
 			monomialOut.Coefficient = m.Coefficient * float64(m.Exponents[foundIndex])
 			monomialOut.Exponents[foundIndex] -= 1
 		}
@@ -644,6 +701,70 @@ func (m Monomial) String() string {
 	return monomialString
 }
 
+/*
+StringSortedByName
+Description:
+
+	Returns a string representation of the monomial in which the variable factors
+	are ordered alphabetically by their names (rather than in the order in which
+	they are stored), e.g. "2 a b^2". Unnamed variables appear as x_<ID>, as in String.
+*/
+func (m Monomial) StringSortedByName() string {
+	// Input Processing
+	err := m.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Sort the factors by name
+	order := m.FactorOrderByName()
+
+	// Create string
+	var parts []string
+
+	// Add coefficient
+	if (m.Coefficient != 1) || (len(m.VariableFactors) == 0) {
+		parts = append(parts, fmt.Sprintf("%v", m.Coefficient))
+	}
+
+	// Add variables
+	for _, ii := range order {
+		factorString := m.VariableFactors[ii].String()
+		if m.Exponents[ii] != 1 {
+			factorString += fmt.Sprintf("^%v", m.Exponents[ii])
+		}
+		parts = append(parts, factorString)
+	}
+
+	// Return
+	return strings.Join(parts, " ")
+}
+
+/*
+FactorOrderByName
+Description:
+
+	Returns the indices of the monomial's variable factors, sorted so that the
+	factors are in alphabetical order by name (with ties broken by ID). Each
+	variable is compared by its String(), so unnamed variables sort as x_<ID>.
+*/
+func (m Monomial) FactorOrderByName() []int {
+	order := make([]int, len(m.VariableFactors))
+	for ii := range order {
+		order[ii] = ii
+	}
+
+	sort.SliceStable(order, func(ii, jj int) bool {
+		vI, vJ := m.VariableFactors[order[ii]], m.VariableFactors[order[jj]]
+		if vI.String() != vJ.String() {
+			return vI.String() < vJ.String()
+		}
+		return vI.ID < vJ.ID
+	})
+
+	return order
+}
+
 /*
 Copy
 Description:
@@ -725,13 +846,24 @@ func (m Monomial) SubstituteAccordingTo(subMap map[Variable]Expression) Expressi
 		panic(err)
 	}
 
-	// Algorithm
-	// Create the monomial
-	var out Expression = K(0.0)
+	subMap = scalarSubstitutionMap(subMap)
 
-	// Iterate through each variable in the monomial
+	// Algorithm
+	// Collect the replacement for each variable (by ID)
+	replacementOf := make(map[uint64]ScalarExpression)
 	for tempVar, tempExp := range subMap {
-		out = out.Substitute(tempVar, tempExp.(ScalarExpression))
+		replacementOf[tempVar.ID] = tempExp.(ScalarExpression)
+	}
+
+	// Substitute every factor at once, so that the result does not depend on the
+	// order of the map (e.g., when a replacement contains another key of subMap)
+	var out Expression = K(m.Coefficient)
+	for ii, factor := range m.VariableFactors {
+		var base ScalarExpression = factor
+		if replacement, tf := replacementOf[factor.ID]; tf {
+			base = replacement
+		}
+		out = out.Multiply(base.Power(m.Exponents[ii]))
 	}
 
 	// Return
@@ -769,3 +901,202 @@ func (m Monomial) At(ii, jj int) ScalarExpression {
 	// Algorithm
 	return m
 }
+
+/*
+Eval
+Description:
+
+	Evaluates the monomial according to the values in assignment, raising
+	each variable factor to its exponent. An error is returned if any
+	variable of the monomial is missing from assignment.
+*/
+func (m Monomial) Eval(assignment map[Variable]float64) (float64, error) {
+	// Input Processing
+	err := m.Check()
+	if err != nil {
+		return 0.0, err
+	}
+
+	// Algorithm
+	value := m.Coefficient
+	for ii, factor := range m.VariableFactors {
+		factorValue, err := factor.Eval(assignment)
+		if err != nil {
+			return 0.0, err
+		}
+		value *= math.Pow(factorValue, float64(m.Exponents[ii]))
+	}
+
+	return value, nil
+}
+
+/*
+Divide
+Description:
+
+	Divides the monomial by the monomial other. The coefficients are divided and the
+	exponents of other are subtracted from the exponents of the matching variables in m.
+	Variables whose exponents become zero are removed from the result.
+	An error is returned if other has a zero coefficient or if the division is not
+	exact (i.e., the result would have a negative exponent on some variable).
+*/
+func (m Monomial) Divide(other Monomial) (Monomial, error) {
+	// Input Processing
+	err := m.Check()
+	if err != nil {
+		return Monomial{}, err
+	}
+
+	err = other.Check()
+	if err != nil {
+		return Monomial{}, err
+	}
+
+	if other.Coefficient == 0.0 {
+		return Monomial{}, fmt.Errorf("cannot divide %v by %v; the divisor has a zero coefficient", m, other)
+	}
+
+	// Algorithm
+	quotient := m.Copy()
+	quotient.Coefficient = m.Coefficient / other.Coefficient
+
+	for ii, v := range other.VariableFactors {
+		foundIndex, _ := FindInSlice(v, quotient.VariableFactors)
+		exponentInM := 0
+		if foundIndex != -1 {
+			exponentInM = quotient.Exponents[foundIndex]
+		}
+
+		if exponentInM < other.Exponents[ii] {
+			return Monomial{}, fmt.Errorf(
+				"cannot divide %v by %v exactly; the exponent of %v would be negative (%v)",
+				m, other, v, exponentInM-other.Exponents[ii],
+			)
+		}
+
+		if foundIndex != -1 {
+			quotient.Exponents[foundIndex] -= other.Exponents[ii]
+		}
+	}
+
+	// Remove the variables with zero exponents
+	factors := []Variable{}
+	exponents := []int{}
+	for ii, v := range quotient.VariableFactors {
+		if quotient.Exponents[ii] != 0 {
+			factors = append(factors, v)
+			exponents = append(exponents, quotient.Exponents[ii])
+		}
+	}
+	quotient.VariableFactors = factors
+	quotient.Exponents = exponents
+
+	return quotient, nil
+}
+
+/*
+Canonicalize
+Description:
+
+	Returns a copy of the monomial in which repeated variable factors are merged
+	by summing their exponents (e.g., x^1 * x^2 becomes x^3) and the variable
+	factors (and their exponents) are sorted by variable ID.
+	Unlike most methods, this can be called on a monomial with repeated factors,
+	which does not pass Check.
+*/
+func (m Monomial) Canonicalize() Monomial {
+	// Input Processing
+	err := m.checkExponents()
+	if err != nil {
+		panic(err)
+	}
+
+	// Merge the repeated factors
+	mOut := Monomial{
+		Coefficient:     m.Coefficient,
+		VariableFactors: []Variable{},
+		Exponents:       []int{},
+	}
+	factorIndex := make(map[uint64]int)
+	for ii, factor := range m.VariableFactors {
+		if index, tf := factorIndex[factor.ID]; tf {
+			mOut.Exponents[index] += m.Exponents[ii]
+			continue
+		}
+
+		factorIndex[factor.ID] = len(mOut.VariableFactors)
+		mOut.VariableFactors = append(mOut.VariableFactors, factor)
+		mOut.Exponents = append(mOut.Exponents, m.Exponents[ii])
+	}
+
+	// Sort the factors
+	sort.Sort(monomialFactorsByID(mOut))
+	return mOut
+}
+
+// monomialFactorsByID implements sort.Interface for sorting the variable
+// factors of a monomial (along with their exponents) by variable ID.
+type monomialFactorsByID Monomial
+
+func (m monomialFactorsByID) Len() int { return len(m.VariableFactors) }
+func (m monomialFactorsByID) Less(ii, jj int) bool {
+	return m.VariableFactors[ii].ID < m.VariableFactors[jj].ID
+}
+func (m monomialFactorsByID) Swap(ii, jj int) {
+	m.VariableFactors[ii], m.VariableFactors[jj] = m.VariableFactors[jj], m.VariableFactors[ii]
+	m.Exponents[ii], m.Exponents[jj] = m.Exponents[jj], m.Exponents[ii]
+}
+
+/*
+SubstituteVector
+Description:
+
+	Substitutes each variable in the vector in with the matching element of
+	replacement.
+*/
+func (m Monomial) SubstituteVector(in VariableVector, replacement VectorExpression) Expression {
+	// Input Processing
+	err := m.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	subMap, err := substitutionMapOf(in, replacement)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return simplifySubstitution(m.SubstituteAccordingTo(subMap))
+}
+
+// coefficientTolerance is the largest difference between two coefficients
+// for which Monomial.Equals (and Polynomial.Equals) considers them equal.
+const coefficientTolerance = 1e-10
+
+/*
+Equals
+Description:
+
+	Returns true if the two monomials have the same variable factors and exponents
+	(in any order) and coefficients that are within coefficientTolerance of each other.
+*/
+func (m Monomial) Equals(other Monomial) bool {
+	// Input Processing
+	err := m.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = other.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	if math.Abs(m.Coefficient-other.Coefficient) > coefficientTolerance {
+		return false
+	}
+
+	return m.MatchesFormOf(other)
+}
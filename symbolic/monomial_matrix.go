@@ -659,12 +659,7 @@ func (mm MonomialMatrix) SubstituteAccordingTo(substitutions map[Variable]Expres
 	}
 
 	// Algorithm
-	var out MatrixExpression = mm
-	for v, expr := range substitutions {
-		postSub := out.Substitute(v, expr.(ScalarExpression))
-		out = postSub.(MatrixExpression)
-	}
-	return out
+	return MatrixSubstituteAccordingToTemplate(mm, substitutions)
 }
 
 /*
@@ -676,3 +671,128 @@ Description:
 func (mm MonomialMatrix) Power(exponent int) Expression {
 	return MatrixPowerTemplate(mm, exponent)
 }
+
+/*
+ElementWisePower
+Description:
+
+	Raises each element of the matrix to the power of the input integer.
+	(Power, by contrast, follows the matrix semantics of the Expression interface.)
+*/
+func (mm MonomialMatrix) ElementWisePower(exponent int) Expression {
+	return MatrixElementWisePowerTemplate(mm, exponent)
+}
+
+/*
+IsConstant
+Description:
+
+	This method returns true if and only if every monomial in the matrix
+	is a constant (i.e., the matrix contains no variables).
+*/
+func (mm MonomialMatrix) IsConstant() bool {
+	// Input Processing
+	err := mm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	for _, mmRow := range mm {
+		for _, monomial := range mmRow {
+			if !monomial.IsConstant() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+/*
+Sum
+Description:
+
+	Adds together all of the elements of the matrix.
+*/
+func (mm MonomialMatrix) Sum() ScalarExpression {
+	return MatrixSumTemplate(mm)
+}
+
+/*
+SubMatrix
+Description:
+
+	Returns a new matrix containing the block of the monomial matrix with
+	row indices in [rowStart, rowEnd) and column indices in [colStart, colEnd).
+*/
+func (mm MonomialMatrix) SubMatrix(rowStart, rowEnd, colStart, colEnd int) MatrixExpression {
+	// Input Processing
+	err := mm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = checkSubMatrixRange(rowStart, rowEnd, colStart, colEnd, mm)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out MonomialMatrix
+	for ii := rowStart; ii < rowEnd; ii++ {
+		var tempRow []Monomial
+		for jj := colStart; jj < colEnd; jj++ {
+			tempRow = append(tempRow, mm[ii][jj].Copy())
+		}
+		out = append(out, tempRow)
+	}
+
+	return out
+}
+
+/*
+Row
+Description:
+
+	Returns row ii of the monomial matrix as a vector expression.
+*/
+func (mm MonomialMatrix) Row(ii int) VectorExpression {
+	return MatrixRowTemplate(mm, ii)
+}
+
+/*
+Column
+Description:
+
+	Returns column jj of the monomial matrix as a vector expression.
+*/
+func (mm MonomialMatrix) Column(jj int) VectorExpression {
+	return MatrixColumnTemplate(mm, jj)
+}
+
+/*
+ToPolynomialMatrix
+Description:
+
+	This function converts the monomial matrix to a polynomial matrix
+	(each entry becomes a polynomial with a single monomial).
+*/
+func (mm MonomialMatrix) ToPolynomialMatrix() PolynomialMatrix {
+	// Input Processing
+	err := mm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var pmOut PolynomialMatrix
+	for _, mmRow := range mm {
+		var pmRow []Polynomial
+		for _, monomial := range mmRow {
+			pmRow = append(pmRow, monomial.ToPolynomial())
+		}
+		pmOut = append(pmOut, pmRow)
+	}
+	return pmOut
+}
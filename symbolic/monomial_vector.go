@@ -170,7 +170,7 @@ func (mv MonomialVector) Plus(term1 interface{}) Expression {
 			// then simply add the coefficients. and return a monomial vector.
 			var mvOut MonomialVector
 			for _, monomial := range mv {
-				mvOut = append(mvOut, monomial.Plus(right).(Monomial))
+				mvOut = append(mvOut, monomial.Plus(right).(Polynomial).Monomials[0])
 			}
 			return mvOut
 		} else {
@@ -210,7 +210,7 @@ func (mv MonomialVector) Plus(term1 interface{}) Expression {
 			// then simply add the coefficients. and return a monomial vector.
 			var mvOut MonomialVector
 			for ii, monomial := range mv {
-				mvOut = append(mvOut, monomial.Plus(right[ii]).(Monomial))
+				mvOut = append(mvOut, monomial.Plus(right[ii]).(Polynomial).Monomials[0])
 			}
 			return mvOut
 		} else {
@@ -619,6 +619,37 @@ func (mv MonomialVector) IsConstant() bool {
 	return true
 }
 
+/*
+ToKVector
+Description:
+
+	Converts the monomial vector into a KVector when every element
+	of the vector is constant. If any element still contains a variable, then an
+	error is returned.
+*/
+func (mv MonomialVector) ToKVector() (KVector, error) {
+	// Input Processing
+	err := mv.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	var kvOut KVector
+	for ii, monomial := range mv {
+		if !monomial.IsConstant() {
+			return nil, fmt.Errorf(
+				"element %v of the monomial vector is not constant (%v); cannot convert to KVector",
+				ii,
+				monomial,
+			)
+		}
+		kvOut = append(kvOut, K(monomial.Coefficient))
+	}
+
+	return kvOut, nil
+}
+
 /*
 ToPolynomialVector
 Description:
@@ -696,13 +727,8 @@ func (mv MonomialVector) SubstituteAccordingTo(subMap map[Variable]Expression) E
 		panic(err)
 	}
 
-	// Setup
-	var out VectorExpression = mv
-	for varKey, expr := range subMap {
-		postSub := out.Substitute(varKey, expr.(ScalarExpression))
-		out = postSub.(VectorExpression)
-	}
-	return out
+	// Algorithm
+	return VectorSubstituteAccordingToTemplate(mv, subMap)
 }
 
 /*
@@ -714,3 +740,97 @@ Description:
 func (mv MonomialVector) Power(exponent int) Expression {
 	return VectorPowerTemplate(mv, exponent)
 }
+
+/*
+Dot
+Description:
+
+	Computes the dot product of the vector with the vector expression other,
+	i.e., the sum of their element-wise products.
+*/
+func (mv MonomialVector) Dot(other VectorExpression) ScalarExpression {
+	return VectorDotTemplate(mv, other)
+}
+
+/*
+ElementWisePower
+Description:
+
+	Raises each element of the vector to the power of the input integer.
+	(Power, by contrast, follows the vector semantics of the Expression interface.)
+*/
+func (mv MonomialVector) ElementWisePower(exponent int) Expression {
+	return VectorElementWisePowerTemplate(mv, exponent)
+}
+
+/*
+SubstituteVector
+Description:
+
+	Substitutes each variable in the vector in with the matching element of
+	replacement.
+*/
+func (mv MonomialVector) SubstituteVector(in VariableVector, replacement VectorExpression) Expression {
+	// Input Processing
+	err := mv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	subMap, err := substitutionMapOf(in, replacement)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return simplifySubstitution(mv.SubstituteAccordingTo(subMap))
+}
+
+/*
+Outer
+Description:
+
+	Computes the outer product of the vector with other, i.e. the matrix
+	whose (ii,jj) element is mv[ii] * other[jj].
+*/
+func (mv MonomialVector) Outer(other VectorExpression) MatrixExpression {
+	return VectorOuterTemplate(mv, other)
+}
+
+/*
+Sum
+Description:
+
+	Adds together all of the elements of the vector.
+*/
+func (mv MonomialVector) Sum() ScalarExpression {
+	return VectorSumTemplate(mv)
+}
+
+/*
+Slice
+Description:
+
+	Returns a new vector containing the elements of the monomial vector with
+	indices in the half-open range [start, end).
+*/
+func (mv MonomialVector) Slice(start, end int) VectorExpression {
+	// Input Processing
+	err := mv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = checkSliceRange(start, end, mv)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out MonomialVector
+	for ii := start; ii < end; ii++ {
+		out = append(out, mv[ii].Copy())
+	}
+
+	return out
+}
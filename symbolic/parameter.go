@@ -0,0 +1,89 @@
+package symbolic
+
+import "fmt"
+
+/*
+parameter.go
+Description:
+	Functions for distinguishing decision variables from parameters
+	(i.e., variables with IsParameter set to true) in an expression.
+*/
+
+/*
+DecisionVariables
+Description:
+
+	Returns the variables in the expression e, excluding any parameters, i.e.,
+	e.Variables() with the parameters removed. The exclusion is a separate
+	function (rather than an option of Variables()) because Variables() is part
+	of the Expression interface, and changing its signature would break every
+	type that implements it.
+*/
+func DecisionVariables(e Expression) []Variable {
+	var varsOut []Variable
+	for _, v := range e.Variables() {
+		if !v.IsParameter {
+			varsOut = append(varsOut, v)
+		}
+	}
+	return varsOut
+}
+
+/*
+Parameters
+Description:
+
+	Returns the parameters in the expression e, excluding any decision variables.
+*/
+func Parameters(e Expression) []Variable {
+	var paramsOut []Variable
+	for _, v := range e.Variables() {
+		if v.IsParameter {
+			paramsOut = append(paramsOut, v)
+		}
+	}
+	return paramsOut
+}
+
+/*
+EvaluateWithParameters
+Description:
+
+	Evaluates the scalar expression e using the values in assignment for the
+	decision variables and the values in parameters for the parameters.
+	An error is returned if a decision variable of e is missing from assignment
+	or if a parameter of e is missing from parameters.
+*/
+func EvaluateWithParameters(e ScalarExpression, assignment map[Variable]float64, parameters map[Variable]float64) (float64, error) {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		return 0.0, err
+	}
+
+	// Merge the decision variable and parameter values
+	values := make(map[Variable]float64)
+	for v, value := range assignment {
+		if !v.IsParameter {
+			values[v] = value
+		}
+	}
+
+	for p, value := range parameters {
+		if p.IsParameter {
+			values[p] = value
+		}
+	}
+
+	for _, v := range e.Variables() {
+		if _, err := v.Eval(values); err != nil {
+			if v.IsParameter {
+				return 0.0, fmt.Errorf("parameter %v is missing from the parameter map", v)
+			}
+			return 0.0, fmt.Errorf("variable %v is missing from the assignment", v)
+		}
+	}
+
+	// Algorithm
+	return e.Eval(values)
+}
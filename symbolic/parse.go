@@ -0,0 +1,283 @@
+package symbolic
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+/*
+parse.go
+Description:
+
+	A small recursive-descent parser which builds polynomials from strings like
+		2*x^2 + 3*(x - y) - 1
+	The grammar is
+		expression := term (('+' | '-') term)*
+		term       := unary ('*' unary)*
+		unary      := ('+' | '-') unary | power
+		power      := primary ('^' integer)?
+		primary    := number | identifier | '(' expression ')'
+	where a number may use exponent notation (e.g., 1e3 or 2.5E-4).
+*/
+
+/*
+ParsePolynomial
+Description:
+
+	Parses the input string into a simplified Polynomial. Identifiers in input are
+	looked up in vars. An error is returned if input contains an unknown identifier
+	or is not well-formed.
+*/
+func ParsePolynomial(input string, vars map[string]Variable) (Polynomial, error) {
+	// Input Processing
+	for name, v := range vars {
+		err := v.Check()
+		if err != nil {
+			return Polynomial{}, fmt.Errorf("variable %v in the map is not well-defined: %v", name, err)
+		}
+	}
+
+	// Algorithm
+	parser := polynomialParser{input: []rune(input), vars: vars}
+	out, err := parser.parseExpression()
+	if err != nil {
+		return Polynomial{}, err
+	}
+
+	parser.skipSpaces()
+	if parser.pos < len(parser.input) {
+		return Polynomial{}, parser.errorf("unexpected character %q", parser.input[parser.pos])
+	}
+
+	return out.Simplify(), nil
+}
+
+// polynomialParser holds the state of ParsePolynomial as it reads through the input.
+type polynomialParser struct {
+	input []rune
+	pos   int
+	vars  map[string]Variable
+}
+
+/*
+errorf
+Description:
+
+	Creates an error describing a problem at the current position of the parser.
+*/
+func (pp *polynomialParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf(
+		"error parsing polynomial at position %v: %v",
+		pp.pos,
+		fmt.Sprintf(format, args...),
+	)
+}
+
+/*
+skipSpaces
+Description:
+
+	Advances the parser past any whitespace.
+*/
+func (pp *polynomialParser) skipSpaces() {
+	for pp.pos < len(pp.input) && unicode.IsSpace(pp.input[pp.pos]) {
+		pp.pos++
+	}
+}
+
+/*
+peek
+Description:
+
+	Returns the next non-whitespace character (without consuming it) or 0 at the
+	end of the input.
+*/
+func (pp *polynomialParser) peek() rune {
+	pp.skipSpaces()
+	if pp.pos >= len(pp.input) {
+		return 0
+	}
+	return pp.input[pp.pos]
+}
+
+/*
+parseExpression
+Description:
+
+	Parses a sum or difference of terms.
+*/
+func (pp *polynomialParser) parseExpression() (Polynomial, error) {
+	out, err := pp.parseTerm()
+	if err != nil {
+		return Polynomial{}, err
+	}
+
+	for {
+		switch pp.peek() {
+		case '+':
+			pp.pos++
+			right, err := pp.parseTerm()
+			if err != nil {
+				return Polynomial{}, err
+			}
+			out = out.Plus(right).(Polynomial)
+		case '-':
+			pp.pos++
+			right, err := pp.parseTerm()
+			if err != nil {
+				return Polynomial{}, err
+			}
+			out = out.Plus(right.Multiply(-1.0)).(Polynomial)
+		default:
+			return out, nil
+		}
+	}
+}
+
+/*
+parseTerm
+Description:
+
+	Parses a product of factors.
+*/
+func (pp *polynomialParser) parseTerm() (Polynomial, error) {
+	out, err := pp.parseUnary()
+	if err != nil {
+		return Polynomial{}, err
+	}
+
+	for pp.peek() == '*' {
+		pp.pos++
+		right, err := pp.parseUnary()
+		if err != nil {
+			return Polynomial{}, err
+		}
+		out = out.Multiply(right).(Polynomial)
+	}
+
+	return out, nil
+}
+
+/*
+parseUnary
+Description:
+
+	Parses a factor with an optional leading sign.
+*/
+func (pp *polynomialParser) parseUnary() (Polynomial, error) {
+	switch pp.peek() {
+	case '+':
+		pp.pos++
+		return pp.parseUnary()
+	case '-':
+		pp.pos++
+		out, err := pp.parseUnary()
+		if err != nil {
+			return Polynomial{}, err
+		}
+		return out.Multiply(-1.0).(Polynomial), nil
+	}
+
+	return pp.parsePower()
+}
+
+/*
+parsePower
+Description:
+
+	Parses a primary expression which may be raised to a non-negative integer power.
+*/
+func (pp *polynomialParser) parsePower() (Polynomial, error) {
+	base, err := pp.parsePrimary()
+	if err != nil {
+		return Polynomial{}, err
+	}
+
+	if pp.peek() != '^' {
+		return base, nil
+	}
+	pp.pos++
+
+	// Read the exponent
+	pp.skipSpaces()
+	start := pp.pos
+	for pp.pos < len(pp.input) && unicode.IsDigit(pp.input[pp.pos]) {
+		pp.pos++
+	}
+	if start == pp.pos {
+		return Polynomial{}, pp.errorf("expected a non-negative integer exponent after '^'")
+	}
+
+	exponent, err := strconv.Atoi(string(pp.input[start:pp.pos]))
+	if err != nil {
+		return Polynomial{}, pp.errorf("invalid exponent %v: %v", string(pp.input[start:pp.pos]), err)
+	}
+
+	return base.Power(exponent).(Polynomial), nil
+}
+
+/*
+parsePrimary
+Description:
+
+	Parses a number (possibly in exponent notation), a variable name or a
+	parenthesized expression.
+*/
+func (pp *polynomialParser) parsePrimary() (Polynomial, error) {
+	next := pp.peek()
+	switch {
+	case next == 0:
+		return Polynomial{}, pp.errorf("unexpected end of input")
+	case next == '(':
+		pp.pos++
+		out, err := pp.parseExpression()
+		if err != nil {
+			return Polynomial{}, err
+		}
+		if pp.peek() != ')' {
+			return Polynomial{}, pp.errorf("expected ')'")
+		}
+		pp.pos++
+		return out, nil
+	case unicode.IsDigit(next) || next == '.':
+		start := pp.pos
+		for pp.pos < len(pp.input) && (unicode.IsDigit(pp.input[pp.pos]) || pp.input[pp.pos] == '.') {
+			pp.pos++
+		}
+
+		// Read the exponent (e.g., the e-4 in 2.5e-4), if there is one
+		if pp.pos < len(pp.input) && (pp.input[pp.pos] == 'e' || pp.input[pp.pos] == 'E') {
+			exponentStart := pp.pos + 1
+			if exponentStart < len(pp.input) && (pp.input[exponentStart] == '+' || pp.input[exponentStart] == '-') {
+				exponentStart++
+			}
+			if exponentStart < len(pp.input) && unicode.IsDigit(pp.input[exponentStart]) {
+				pp.pos = exponentStart
+				for pp.pos < len(pp.input) && unicode.IsDigit(pp.input[pp.pos]) {
+					pp.pos++
+				}
+			}
+		}
+
+		value, err := strconv.ParseFloat(string(pp.input[start:pp.pos]), 64)
+		if err != nil {
+			return Polynomial{}, pp.errorf("invalid number %v", string(pp.input[start:pp.pos]))
+		}
+		return K(value).ToPolynomial(), nil
+	case unicode.IsLetter(next) || next == '_':
+		start := pp.pos
+		for pp.pos < len(pp.input) && (unicode.IsLetter(pp.input[pp.pos]) || unicode.IsDigit(pp.input[pp.pos]) || pp.input[pp.pos] == '_') {
+			pp.pos++
+		}
+		name := string(pp.input[start:pp.pos])
+		v, tf := pp.vars[name]
+		if !tf {
+			pp.pos = start
+			return Polynomial{}, pp.errorf("unknown variable %v", name)
+		}
+		return v.ToPolynomial(), nil
+	}
+
+	return Polynomial{}, pp.errorf("unexpected character %q", next)
+}
@@ -2,6 +2,10 @@ package symbolic
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
 
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
@@ -235,9 +239,21 @@ func (p Polynomial) Minus(e interface{}) Expression {
 		//if err != nil {
 		//	panic(err)
 		//}
+	}
 
-		// Use Expression's Minus() method
-		return Minus(p, eAsE)
+	// Algorithm
+	switch right := e.(type) {
+	case float64:
+		return p.Minus(K(right))
+	case K, Variable, Monomial, Polynomial:
+		// Add the negated scalar and combine like terms
+		negatedRight, _ := toPolynomial(right)
+		difference, _ := toPolynomial(p.Plus(negatedRight.Multiply(-1.0)))
+		return difference.Simplify()
+	case KVector, VariableVector, MonomialVector, PolynomialVector,
+		KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix:
+		// Use Expression's Minus() method to broadcast p
+		return Minus(p, right.(Expression))
 	}
 
 	// If the function has reached this point, then
@@ -394,7 +410,6 @@ func (p Polynomial) Multiply(e interface{}) Expression {
 		// Multiply each monomial of the polynomial by the polynomial
 		var productOut Expression = K(0.0)
 		for ii := 0; ii < len(right.Monomials); ii++ {
-			fmt.Println(fmt.Sprintf("pCopy.Multiply(right.Monomials[ii]): %v", pCopy.Multiply(right.Monomials[ii])))
 			productOut = productOut.Plus(
 				pCopy.Multiply(right.Monomials[ii]),
 			)
@@ -542,60 +557,102 @@ Description:
 
 	This function simplifies the number of monomials in the polynomial,
 	by finding the matching terms (i.e., monomials with matching Variables and Exponents)
-	and combining them.
+	and combining them. Terms whose coefficients cancel out are dropped and each
+	monomial is canonicalized (see Monomial.Canonicalize), so that equal polynomials
+	have matching monomials. Monomials with repeated variable factors are merged
+	before the polynomial is checked.
 */
 func (p Polynomial) Simplify() Polynomial {
 	// Input Processing
-	err := p.Check()
-	if err != nil {
-		panic(err)
-	}
-
-	// Find first element that has nonzero coefficient
-	firstNonZeroIndex := -1
+	var canonical Polynomial
 	for ii, monomial := range p.Monomials {
-		if monomial.Coefficient != 0.0 {
-			firstNonZeroIndex = ii
-			break
+		err := monomial.checkExponents()
+		if err != nil {
+			panic(fmt.Errorf("error in monomial %v: %v", ii, err))
 		}
+		canonical.Monomials = append(canonical.Monomials, monomial.Canonicalize())
 	}
 
-	if len(p.Monomials) == 0 || firstNonZeroIndex == -1 {
-		// If there is only a single, zero monomial, then return the zero polynomial
-		return p
-	}
-
-	// Copy the first element of the polynomial into the new polynomial
-	pCopy := Polynomial{
-		Monomials: []Monomial{p.Monomials[firstNonZeroIndex]},
+	err := canonical.Check()
+	if err != nil {
+		panic(err)
 	}
 
-	// Loop through the rest of the monomials
-	for ii := firstNonZeroIndex + 1; ii < len(p.Monomials); ii++ {
+	// Combine the monomials with matching variable factors and exponents
+	var combined Polynomial
+	for _, monomial := range canonical.Monomials {
 		// Check to see if the monomials coefficient is zero
-		if p.Monomials[ii].Coefficient == 0.0 {
+		if monomial.Coefficient == 0.0 {
 			// Don't add it.
 			continue
 		}
 
 		// Check to see if the monomial is already in the polynomial
-		monomialIndex := pCopy.MonomialIndex(p.Monomials[ii])
+		monomialIndex := -1
+		for ii, combinedMonomial := range combined.Monomials {
+			if combinedMonomial.MatchesFormOf(monomial) {
+				monomialIndex = ii
+				break
+			}
+		}
+
 		if monomialIndex == -1 {
 			// Polynomial does not contain the monomial,
 			// so add a new monomial.
-			pCopy.Monomials = append(pCopy.Monomials, p.Monomials[ii])
+			combined.Monomials = append(combined.Monomials, monomial)
 		} else {
 			// Monomial does contain the variable, so
 			// modify the monomial which represents that variable.
-			newMonomial := pCopy.Monomials[monomialIndex]
-			newMonomial.Coefficient += p.Monomials[ii].Coefficient
-			pCopy.Monomials[monomialIndex] = newMonomial
+			combined.Monomials[monomialIndex].Coefficient += monomial.Coefficient
 		}
 	}
 
+	// Drop the monomials whose coefficients cancelled out
+	var pOut Polynomial
+	for _, monomial := range combined.Monomials {
+		if monomial.Coefficient != 0.0 {
+			pOut.Monomials = append(pOut.Monomials, monomial)
+		}
+	}
+
+	if len(pOut.Monomials) == 0 {
+		// If every monomial cancelled, then return the zero polynomial
+		return K(0.0).ToPolynomial()
+	}
+
 	// Return the simplified polynomial
-	return pCopy
+	return pOut
+}
 
+/*
+RemoveZeroTerms
+Description:
+
+	Returns a copy of the polynomial without the monomials whose coefficients
+	have magnitude at most tol. If every monomial is removed, then the zero
+	polynomial (a single constant monomial with coefficient 0) is returned,
+	so that the result always passes Check.
+*/
+func (p Polynomial) RemoveZeroTerms(tol float64) Polynomial {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var pOut Polynomial
+	for _, monomial := range p.Monomials {
+		if math.Abs(monomial.Coefficient) > tol {
+			pOut.Monomials = append(pOut.Monomials, monomial.Copy())
+		}
+	}
+
+	if len(pOut.Monomials) == 0 {
+		return K(0.0).ToPolynomial()
+	}
+
+	return pOut
 }
 
 /*
@@ -671,6 +728,36 @@ func (p Polynomial) Degree() int {
 	return degree
 }
 
+/*
+HomogeneousPart
+Description:
+
+	Returns the sum of all monomials in the polynomial whose total degree is
+	exactly equal to the input degree. If no such monomials exist, then the
+	zero polynomial is returned.
+*/
+func (p Polynomial) HomogeneousPart(degree int) Polynomial {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var pOut Polynomial
+	for _, monomial := range p.Monomials {
+		if monomial.Degree() == degree {
+			pOut.Monomials = append(pOut.Monomials, monomial.Copy())
+		}
+	}
+
+	if len(pOut.Monomials) == 0 {
+		return K(0.0).ToPolynomial()
+	}
+
+	return pOut
+}
+
 /*
 LinearCoeff
 Description:
@@ -765,6 +852,78 @@ func (p Polynomial) String() string {
 	return polynomialString
 }
 
+/*
+StringSortedByName
+Description:
+
+	Returns a string representation of the polynomial in which the factors of each
+	monomial are ordered alphabetically by variable name and the monomials are
+	ordered alphabetically by their (sorted) variable names, with constants last.
+	For example, "2 a b + c + 3".
+*/
+func (p Polynomial) StringSortedByName() string {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Collect the sorted names of each monomial's factors
+	sortedNames := make([][]string, len(p.Monomials))
+	for ii, monomial := range p.Monomials {
+		for _, jj := range monomial.FactorOrderByName() {
+			sortedNames[ii] = append(sortedNames[ii], monomial.VariableFactors[jj].String())
+		}
+	}
+
+	// Sort the monomials
+	order := make([]int, len(p.Monomials))
+	for ii := range order {
+		order[ii] = ii
+	}
+
+	sort.SliceStable(order, func(ii, jj int) bool {
+		namesI, namesJ := sortedNames[order[ii]], sortedNames[order[jj]]
+		switch {
+		case len(namesI) == 0 || len(namesJ) == 0:
+			// Constants go last
+			return len(namesJ) == 0 && len(namesI) != 0
+		}
+
+		for kk := 0; kk < len(namesI) && kk < len(namesJ); kk++ {
+			if namesI[kk] != namesJ[kk] {
+				return namesI[kk] < namesJ[kk]
+			}
+		}
+		return len(namesI) < len(namesJ)
+	})
+
+	// Create string
+	var monomialStrings []string
+	for _, ii := range order {
+		monomialStrings = append(monomialStrings, p.Monomials[ii].StringSortedByName())
+	}
+
+	return strings.Join(monomialStrings, " + ")
+}
+
+/*
+ToMatrix
+Description:
+
+	Wraps the polynomial in a 1x1 PolynomialMatrix.
+*/
+func (p Polynomial) ToMatrix() PolynomialMatrix {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return PolynomialMatrix{{p.Copy()}}
+}
+
 /*
 Substitute
 Description:
@@ -789,13 +948,13 @@ func (p Polynomial) Substitute(vIn Variable, eIn ScalarExpression) Expression {
 	}
 
 	// Algorithm
-	var out Expression = K(0.0)
+	out := K(0.0).ToPolynomial()
 	for _, monomial := range p.Monomials {
-		newMonomial := monomial.Substitute(vIn, eIn)
-		out = out.Plus(newMonomial).(Polynomial).Simplify()
+		newMonomial, _ := toPolynomial(monomial.Substitute(vIn, eIn))
+		out = out.Plus(newMonomial).(Polynomial)
 	}
 
-	return out
+	return out.Simplify()
 }
 
 /*
@@ -830,10 +989,177 @@ func (p Polynomial) SubstituteAccordingTo(subMap map[Variable]Expression) Expres
 Power
 Description:
 
-	Computes the power of the constant.
+	Computes the power of the polynomial and returns the fully expanded
+	polynomial with like terms combined. An exponent of 0 gives the
+	constant polynomial 1 and an exponent of 1 gives a copy of p.
 */
 func (p Polynomial) Power(exponent int) Expression {
-	return ScalarPowerTemplate(p, exponent)
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if exponent < 0 {
+		panic(smErrors.NegativeExponentError{Exponent: exponent})
+	}
+
+	// Algorithm
+	switch exponent {
+	case 0:
+		return K(1.0).ToPolynomial()
+	case 1:
+		return p.Copy()
+	}
+
+	// Expand the power by repeated squaring, combining like terms
+	// after every product
+	result := K(1.0).ToPolynomial()
+	base := p.Simplify()
+	for exponent > 0 {
+		if exponent%2 == 1 {
+			result = result.Multiply(base).(Polynomial).Simplify()
+		}
+		exponent /= 2
+		if exponent > 0 {
+			base = base.Multiply(base).(Polynomial).Simplify()
+		}
+	}
+
+	return result
+}
+
+/*
+ReplaceMonomial
+Description:
+
+	Replaces every monomial in the polynomial that matches the form of pattern
+	(i.e., has the same variables and exponents, ignoring the coefficient) with
+	the replacement expression scaled by the matched monomial's coefficient.
+	For example, replacing x^2 with w in 3 x^2 + x gives 3 w + x.
+*/
+func (p Polynomial) ReplaceMonomial(pattern Monomial, replacement Expression) Polynomial {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = pattern.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	replacementAsP, err := toPolynomial(replacement)
+	if err != nil {
+		panic(
+			smErrors.UnsupportedInputError{
+				FunctionName: "Polynomial.ReplaceMonomial",
+				Input:        replacement,
+			},
+		)
+	}
+
+	err = replacementAsP.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var pOut Polynomial
+	for _, monomial := range p.Monomials {
+		if monomial.MatchesFormOf(pattern) {
+			scaled := replacementAsP.Multiply(monomial.Coefficient).(Polynomial)
+			pOut.Monomials = append(pOut.Monomials, scaled.Monomials...)
+		} else {
+			pOut.Monomials = append(pOut.Monomials, monomial)
+		}
+	}
+
+	return pOut.Simplify()
+}
+
+/*
+PowerCapped
+Description:
+
+	Computes the power of the polynomial by repeated multiplication, but
+	returns an error as soon as an intermediate result contains more than
+	maxTerms monomials. This protects against accidental combinatorial blowups
+	when expanding high-degree powers of multivariate polynomials.
+*/
+func (p Polynomial) PowerCapped(exponent, maxTerms int) (Polynomial, error) {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		return p, err
+	}
+
+	if exponent < 0 {
+		return p, smErrors.NegativeExponentError{Exponent: exponent}
+	}
+
+	// Algorithm
+	result := K(1.0).ToPolynomial()
+	for ii := 0; ii < exponent; ii++ {
+		result = result.Multiply(p).(Polynomial)
+		if len(result.Monomials) > maxTerms {
+			return p, fmt.Errorf(
+				"expansion of power %v of polynomial exceeded the maximum number of terms (%v > %v)",
+				exponent,
+				len(result.Monomials),
+				maxTerms,
+			)
+		}
+	}
+
+	return result, nil
+}
+
+/*
+ReduceIntegerCoefficients
+Description:
+
+	When all of the coefficients of the polynomial are integers, this method
+	divides each coefficient by their greatest common divisor and returns the
+	reduced polynomial along with the factor that was removed.
+	If any coefficient is not an integer, then the polynomial is returned
+	unchanged with a factor of 1.
+*/
+func (p Polynomial) ReduceIntegerCoefficients() (Polynomial, int) {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Compute the GCD of all coefficients (if they are all integers)
+	gcd := 0
+	for _, monomial := range p.Monomials {
+		if monomial.Coefficient != math.Trunc(monomial.Coefficient) {
+			return p.Copy(), 1
+		}
+
+		// Euclid's algorithm on the absolute values
+		a, b := gcd, int(math.Abs(monomial.Coefficient))
+		for b != 0 {
+			a, b = b, a%b
+		}
+		gcd = a
+	}
+
+	if gcd <= 1 {
+		return p.Copy(), 1
+	}
+
+	// Algorithm
+	pCopy := p.Copy()
+	for ii, monomial := range pCopy.Monomials {
+		monomial.Coefficient = monomial.Coefficient / float64(gcd)
+		pCopy.Monomials[ii] = monomial
+	}
+
+	return pCopy, gcd
 }
 
 /*
@@ -862,3 +1188,442 @@ func (p Polynomial) At(ii, jj int) ScalarExpression {
 	// Algorithm
 	return p
 }
+
+/*
+CoefficientInProduct
+Description:
+
+	Returns the coefficient of the monomial with the same form as target
+	(i.e., the same variables and exponents) in the product a * b.
+	Instead of expanding the full product, this only multiplies the pairs of
+	monomials from a and b whose product has the same total degree as target.
+*/
+func CoefficientInProduct(a, b Polynomial, target Monomial) float64 {
+	// Input Processing
+	err := a.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = b.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = target.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Constants
+	targetDegree := target.Degree()
+
+	// Algorithm
+	coefficient := 0.0
+	for _, monomialA := range a.Monomials {
+		for _, monomialB := range b.Monomials {
+			// Skip pairs that can not produce the target
+			if monomialA.Degree()+monomialB.Degree() != targetDegree {
+				continue
+			}
+
+			product := monomialA.Multiply(monomialB).(Monomial)
+			if product.MatchesFormOf(target) {
+				coefficient += product.Coefficient
+			}
+		}
+	}
+
+	return coefficient
+}
+
+/*
+toPolynomial
+Description:
+
+	Converts the input scalar expression (or float64) into a Polynomial.
+	An error is returned if the input is not a scalar expression.
+*/
+func toPolynomial(e interface{}) (Polynomial, error) {
+	// Input Processing
+	if !IsScalarExpression(e) {
+		return Polynomial{}, fmt.Errorf(
+			"the input interface is of type %T, which is not recognized as a ScalarExpression.",
+			e,
+		)
+	}
+
+	// Convert
+	switch e2 := e.(type) {
+	case float64:
+		return K(e2).ToPolynomial(), nil
+	case K:
+		return e2.ToPolynomial(), nil
+	case Variable:
+		return e2.ToPolynomial(), nil
+	case Monomial:
+		return e2.ToPolynomial(), nil
+	case Polynomial:
+		return e2.Copy(), nil
+	default:
+		return Polynomial{}, fmt.Errorf(
+			"unexpected polynomial conversion requested for type %T!",
+			e,
+		)
+	}
+}
+
+/*
+RandomPolynomial
+Description:
+
+	Creates a random (but valid) polynomial in the variables vars using the
+	random number generator rng. The polynomial has between 1 and maxMonomials
+	monomials, each of total degree at most maxDegree, with nonzero integer
+	coefficients. This is useful for fuzzing arithmetic identities.
+*/
+func RandomPolynomial(vars []Variable, maxMonomials, maxDegree int, rng *rand.Rand) Polynomial {
+	// Input Processing
+	for _, v := range vars {
+		err := v.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if maxMonomials < 1 {
+		panic(
+			fmt.Errorf("RandomPolynomial: maxMonomials must be at least 1; received %v", maxMonomials),
+		)
+	}
+
+	if maxDegree < 0 {
+		panic(smErrors.NegativeExponentError{Exponent: maxDegree})
+	}
+
+	// Constants
+	uniqueVars := UniqueVars(vars)
+	nMonomials := 1 + rng.Intn(maxMonomials)
+
+	// Algorithm
+	var pOut Polynomial
+	for ii := 0; ii < nMonomials; ii++ {
+		// Choose a nonzero integer coefficient
+		coefficient := float64(1 + rng.Intn(9))
+		if rng.Intn(2) == 0 {
+			coefficient = -coefficient
+		}
+
+		// Distribute the degree of the monomial among the variables
+		exponents := make([]int, len(uniqueVars))
+		if len(uniqueVars) > 0 {
+			degree := rng.Intn(maxDegree + 1)
+			for jj := 0; jj < degree; jj++ {
+				exponents[rng.Intn(len(uniqueVars))] += 1
+			}
+		}
+
+		// Create the monomial (with factors in the same order as uniqueVars)
+		monomial := Monomial{Coefficient: coefficient}
+		for jj, v := range uniqueVars {
+			if exponents[jj] > 0 {
+				monomial.VariableFactors = append(monomial.VariableFactors, v)
+				monomial.Exponents = append(monomial.Exponents, exponents[jj])
+			}
+		}
+
+		pOut.Monomials = append(pOut.Monomials, monomial)
+	}
+
+	return pOut.Simplify()
+}
+
+/*
+Eval
+Description:
+
+	Evaluates the polynomial according to the values in assignment.
+	An error is returned if any variable of the polynomial is missing
+	from assignment.
+*/
+func (p Polynomial) Eval(assignment map[Variable]float64) (float64, error) {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		return 0.0, err
+	}
+
+	// Algorithm
+	value := 0.0
+	for _, monomial := range p.Monomials {
+		monomialValue, err := monomial.Eval(assignment)
+		if err != nil {
+			return 0.0, err
+		}
+		value += monomialValue
+	}
+
+	return value, nil
+}
+
+/*
+IsConvexQuadratic
+Description:
+
+	Determines whether or not the polynomial is convex in the variables wrt
+	by checking that its (constant) Hessian with respect to wrt is positive
+	semidefinite. An error is returned if the polynomial has degree greater
+	than 2, because the Hessian is then not constant.
+*/
+func (p Polynomial) IsConvexQuadratic(wrt []Variable) (bool, error) {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		return false, err
+	}
+
+	if p.Degree() > 2 {
+		return false, fmt.Errorf(
+			"IsConvexQuadratic: polynomial has degree %v; only polynomials of degree at most 2 are supported",
+			p.Degree(),
+		)
+	}
+
+	// Constants
+	tol := 1e-10
+
+	// Algorithm
+	H, ok := Hessian(p, wrt).(KMatrix)
+	if !ok {
+		return false, fmt.Errorf("IsConvexQuadratic: the Hessian of %v is not constant", p)
+	}
+
+	// Check that the smallest eigenvalue of the Hessian is nonnegative
+	HAsDense := H.ToDense()
+	HAsSym := mat.NewSymDense(len(wrt), HAsDense.RawMatrix().Data)
+
+	var eig mat.EigenSym
+	ok = eig.Factorize(HAsSym, false)
+	if !ok {
+		return false, fmt.Errorf("IsConvexQuadratic: eigenvalue decomposition of the Hessian failed")
+	}
+
+	for _, lambda := range eig.Values(nil) {
+		if lambda < -tol {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+/*
+SubstituteVector
+Description:
+
+	Substitutes each variable in the vector in with the matching element of
+	replacement.
+*/
+func (p Polynomial) SubstituteVector(in VariableVector, replacement VectorExpression) Expression {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	subMap, err := substitutionMapOf(in, replacement)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return simplifySubstitution(p.SubstituteAccordingTo(subMap))
+}
+
+/*
+Collect
+Description:
+
+	Groups the monomials of the polynomial by the exponent of the variable v.
+	The output maps each exponent of v to the coefficient polynomial (in the
+	remaining variables) of that power of v, e.g. collecting x^2 y + 3 x + 2 on x
+	gives {2: y, 1: 3, 0: 2}.
+	By default, if v does not appear in the polynomial, then the whole polynomial
+	is returned under the key 0. If strictIn is given as true, then this case
+	panics instead.
+*/
+func (p Polynomial) Collect(v Variable, strictIn ...bool) map[int]Polynomial {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	strict := false
+	switch len(strictIn) {
+	case 0:
+	case 1:
+		strict = strictIn[0]
+	default:
+		panic(fmt.Errorf("Too many inputs provided to Collect() method."))
+	}
+
+	if strict {
+		found := false
+		for _, pVar := range p.Variables() {
+			if pVar.ID == v.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			panic(
+				fmt.Errorf("Collect: variable %v does not appear in the polynomial %v", v, p),
+			)
+		}
+	}
+
+	// Algorithm
+	groups := make(map[int]Polynomial)
+	for _, monomial := range p.Monomials {
+		// Split the monomial into the power of v and the remaining factors
+		exponent := 0
+		remainder := Monomial{Coefficient: monomial.Coefficient}
+		for ii, factor := range monomial.VariableFactors {
+			if factor.ID == v.ID {
+				exponent += monomial.Exponents[ii]
+				continue
+			}
+			remainder.VariableFactors = append(remainder.VariableFactors, factor)
+			remainder.Exponents = append(remainder.Exponents, monomial.Exponents[ii])
+		}
+
+		group, tf := groups[exponent]
+		if !tf {
+			group = Polynomial{}
+		}
+		group.Monomials = append(group.Monomials, remainder)
+		groups[exponent] = group
+	}
+
+	for exponent, group := range groups {
+		groups[exponent] = group.Simplify()
+	}
+
+	return groups
+}
+
+/*
+CoefficientOf
+Description:
+
+	Returns the coefficient of the monomial m in the polynomial. Monomials are
+	matched by their variables and exponents (the coefficient of m is ignored),
+	and the coefficients of all matching monomials are summed. If no monomial of
+	the polynomial matches, then 0 is returned.
+*/
+func (p Polynomial) CoefficientOf(m Monomial) float64 {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = m.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	coefficient := 0.0
+	for _, monomial := range p.Monomials {
+		if monomial.MatchesFormOf(m) {
+			coefficient += monomial.Coefficient
+		}
+	}
+
+	return coefficient
+}
+
+/*
+Equals
+Description:
+
+	Returns true if the two polynomials are the same after simplification.
+	The monomials of both polynomials are put into a canonical order and then
+	compared pairwise with Monomial.Equals.
+*/
+func (p Polynomial) Equals(other Polynomial) bool {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = other.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	pCanonical := p.canonical()
+	otherCanonical := other.canonical()
+
+	if len(pCanonical.Monomials) != len(otherCanonical.Monomials) {
+		return false
+	}
+
+	for ii, monomial := range pCanonical.Monomials {
+		if !monomial.Equals(otherCanonical.Monomials[ii]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+canonical
+Description:
+
+	Returns the simplified polynomial with its monomials sorted into a canonical
+	order, so that equal polynomials have the same monomials in the same order.
+*/
+func (p Polynomial) canonical() Polynomial {
+	// Algorithm
+	out := p.Simplify()
+	sort.Sort(polynomialMonomialsInOrder(out))
+	return out
+}
+
+// polynomialMonomialsInOrder implements sort.Interface for sorting the monomials
+// of a simplified polynomial into a canonical order: by degree and then by the
+// IDs and exponents of their (sorted) variable factors.
+type polynomialMonomialsInOrder Polynomial
+
+func (p polynomialMonomialsInOrder) Len() int { return len(p.Monomials) }
+func (p polynomialMonomialsInOrder) Less(ii, jj int) bool {
+	mI, mJ := p.Monomials[ii], p.Monomials[jj]
+	if mI.Degree() != mJ.Degree() {
+		return mI.Degree() < mJ.Degree()
+	}
+
+	for kk := 0; kk < len(mI.VariableFactors) && kk < len(mJ.VariableFactors); kk++ {
+		if mI.VariableFactors[kk].ID != mJ.VariableFactors[kk].ID {
+			return mI.VariableFactors[kk].ID < mJ.VariableFactors[kk].ID
+		}
+		if mI.Exponents[kk] != mJ.Exponents[kk] {
+			return mI.Exponents[kk] < mJ.Exponents[kk]
+		}
+	}
+
+	return len(mI.VariableFactors) < len(mJ.VariableFactors)
+}
+func (p polynomialMonomialsInOrder) Swap(ii, jj int) {
+	p.Monomials[ii], p.Monomials[jj] = p.Monomials[jj], p.Monomials[ii]
+}
@@ -2,6 +2,7 @@ package symbolic
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
@@ -491,7 +492,8 @@ func (pm PolynomialMatrix) DerivativeWrt(vIn Variable) Expression {
 	for _, row := range pm {
 		var dpmRow []Polynomial
 		for _, polynomial := range row {
-			dpmRow = append(dpmRow, polynomial.DerivativeWrt(vIn).(Polynomial))
+			dPolynomial, _ := toPolynomial(polynomial.DerivativeWrt(vIn))
+			dpmRow = append(dpmRow, dPolynomial)
 		}
 		dpm = append(dpm, dpmRow)
 	}
@@ -524,6 +526,181 @@ func (pm PolynomialMatrix) At(ii int, jj int) ScalarExpression {
 	return pm[ii][jj]
 }
 
+/*
+Slice
+Description:
+
+	Returns the submatrix of pm that contains the rows listed in rows and the
+	columns listed in cols (in the given order). An error is returned if any of the
+	indices are out of range or if either list of indices is empty.
+*/
+func (pm PolynomialMatrix) Slice(rows, cols []int) (PolynomialMatrix, error) {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 || len(cols) == 0 {
+		return nil, fmt.Errorf(
+			"cannot slice a polynomial matrix with %v row indices and %v column indices; expected at least one of each",
+			len(rows),
+			len(cols),
+		)
+	}
+
+	for _, ii := range rows {
+		for _, jj := range cols {
+			err = smErrors.CheckIndexOnMatrix(ii, jj, pm)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Algorithm
+	var pmOut PolynomialMatrix
+	for _, ii := range rows {
+		var pmRow []Polynomial
+		for _, jj := range cols {
+			pmRow = append(pmRow, pm[ii][jj].Copy())
+		}
+		pmOut = append(pmOut, pmRow)
+	}
+
+	return pmOut, nil
+}
+
+/*
+LinearCoeff
+Description:
+
+	Retrieves the coefficients of the linear terms in the (affine) polynomial matrix.
+	The matrix is vectorized column-major, so that element (ii,jj) of the output
+	describes the coefficient of variable jj (from pm.Variables() or the optional
+	input slice) in the entry pm[ii % nRows][ii / nRows].
+*/
+func (pm PolynomialMatrix) LinearCoeff(vSlices ...[]Variable) mat.Dense {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Check to see if the user provided a slice of variables
+	var varSlice []Variable
+	switch len(vSlices) {
+	case 0:
+		varSlice = pm.Variables()
+	case 1:
+		varSlice = vSlices[0]
+	default:
+		panic(fmt.Errorf("Too many inputs provided to LinearCoeff() method."))
+	}
+
+	if len(varSlice) == 0 {
+		panic(
+			smErrors.CanNotGetLinearCoeffOfConstantError{Expression: pm},
+		)
+	}
+
+	// Vectorize the matrix, column by column
+	nRows, nCols := pm.Dims()[0], pm.Dims()[1]
+	var vectorized PolynomialVector
+	for jj := 0; jj < nCols; jj++ {
+		for ii := 0; ii < nRows; ii++ {
+			if pm[ii][jj].Degree() > 1 {
+				panic(
+					fmt.Errorf(
+						"LinearCoeff: entry (%v,%v) of the polynomial matrix has degree %v; only affine matrices are supported",
+						ii, jj, pm[ii][jj].Degree(),
+					),
+				)
+			}
+			vectorized = append(vectorized, pm[ii][jj])
+		}
+	}
+
+	// Algorithm
+	return vectorized.LinearCoeff(varSlice)
+}
+
+/*
+CooTriplets
+Description:
+
+	Describes the same coefficient matrix as LinearCoeff(wrt) in coordinate (COO)
+	form, without creating the dense matrix. Entry kk of the output says that the
+	coefficient matrix has the value vals[kk] at (rows[kk], cols[kk]); zero
+	coefficients are skipped. The triplets are sorted by row and then by column.
+	Panics if any entry of the matrix has degree greater than one.
+*/
+func (pm PolynomialMatrix) CooTriplets(wrt []Variable) (rows, cols []int, vals []float64) {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Constants
+	nRows, nCols := pm.Dims()[0], pm.Dims()[1]
+	wrtIndex := make(map[uint64]int)
+	for jj, v := range wrt {
+		if _, tf := wrtIndex[v.ID]; !tf {
+			wrtIndex[v.ID] = jj
+		}
+	}
+
+	// Algorithm
+	for jj := 0; jj < nCols; jj++ {
+		for ii := 0; ii < nRows; ii++ {
+			entry := pm[ii][jj]
+			if entry.Degree() > 1 {
+				panic(
+					fmt.Errorf(
+						"CooTriplets: entry (%v,%v) of the polynomial matrix has degree %v; only affine matrices are supported",
+						ii, jj, entry.Degree(),
+					),
+				)
+			}
+
+			// Collect the coefficients of the variables in wrt
+			coeffs := make(map[int]float64)
+			for _, monomial := range entry.Monomials {
+				if monomial.Degree() != 1 {
+					continue
+				}
+
+				for kk, v := range monomial.VariableFactors {
+					if monomial.Exponents[kk] == 0 {
+						continue
+					}
+					if col, tf := wrtIndex[v.ID]; tf {
+						coeffs[col] += monomial.Coefficient
+					}
+				}
+			}
+
+			// Emit the nonzero coefficients in order of their column
+			var entryCols []int
+			for col, coeff := range coeffs {
+				if coeff != 0.0 {
+					entryCols = append(entryCols, col)
+				}
+			}
+			sort.Ints(entryCols)
+
+			for _, col := range entryCols {
+				rows = append(rows, jj*nRows+ii)
+				cols = append(cols, col)
+				vals = append(vals, coeffs[col])
+			}
+		}
+	}
+
+	return rows, cols, vals
+}
+
 /*
 Constant
 Description:
@@ -639,6 +816,62 @@ func (pm PolynomialMatrix) Degree() int {
 	return maxDegree
 }
 
+/*
+MaxDegreePerVariable
+Description:
+
+	Returns a map from each variable appearing in the polynomial matrix to the
+	maximum exponent of that variable across all of the matrix's entries.
+*/
+func (pm PolynomialMatrix) MaxDegreePerVariable() map[Variable]int {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	degrees := make(map[Variable]int)
+	for _, row := range pm {
+		for _, p := range row {
+			for _, monomial := range p.Monomials {
+				for ii, v := range monomial.VariableFactors {
+					if monomial.Exponents[ii] > degrees[v] {
+						degrees[v] = monomial.Exponents[ii]
+					}
+				}
+			}
+		}
+	}
+
+	return degrees
+}
+
+/*
+ToScalar
+Description:
+
+	Extracts the single polynomial from a 1x1 PolynomialMatrix.
+	An error is returned if the matrix is not 1x1.
+*/
+func (pm PolynomialMatrix) ToScalar() (Polynomial, error) {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		return Polynomial{}, err
+	}
+
+	if pm.Dims()[0] != 1 || pm.Dims()[1] != 1 {
+		return Polynomial{}, fmt.Errorf(
+			"cannot convert a polynomial matrix of dimension %v into a scalar; expected dimension [1 1]",
+			pm.Dims(),
+		)
+	}
+
+	// Algorithm
+	return pm[0][0].Copy(), nil
+}
+
 /*
 Substitute
 Description:
@@ -668,13 +901,7 @@ func (pm PolynomialMatrix) SubstituteAccordingTo(subMap map[Variable]Expression)
 	}
 
 	// Algorithm
-	var out MatrixExpression = pm
-	for v, e := range subMap {
-		outSubbed := out.Substitute(v, e.(ScalarExpression))
-		out = outSubbed.(MatrixExpression)
-	}
-
-	return out
+	return MatrixSubstituteAccordingToTemplate(pm, subMap)
 }
 
 /*
@@ -686,3 +913,154 @@ Description:
 func (pm PolynomialMatrix) Power(exponent int) Expression {
 	return MatrixPowerTemplate(pm, exponent)
 }
+
+/*
+ElementWisePower
+Description:
+
+	Raises each element of the matrix to the power of the input integer.
+	(Power, by contrast, follows the matrix semantics of the Expression interface.)
+*/
+func (pm PolynomialMatrix) ElementWisePower(exponent int) Expression {
+	return MatrixElementWisePowerTemplate(pm, exponent)
+}
+
+/*
+IsConstant
+Description:
+
+	This method returns true if and only if every polynomial in the matrix
+	is a constant (i.e., the matrix contains no variables).
+*/
+func (pm PolynomialMatrix) IsConstant() bool {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	for _, pmRow := range pm {
+		for _, polynomial := range pmRow {
+			if !polynomial.IsConstant() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+/*
+Sum
+Description:
+
+	Adds together all of the elements of the matrix.
+*/
+func (pm PolynomialMatrix) Sum() ScalarExpression {
+	return MatrixSumTemplate(pm)
+}
+
+/*
+SubMatrix
+Description:
+
+	Returns a new matrix containing the block of the polynomial matrix with
+	row indices in [rowStart, rowEnd) and column indices in [colStart, colEnd).
+*/
+func (pm PolynomialMatrix) SubMatrix(rowStart, rowEnd, colStart, colEnd int) MatrixExpression {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = checkSubMatrixRange(rowStart, rowEnd, colStart, colEnd, pm)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out PolynomialMatrix
+	for ii := rowStart; ii < rowEnd; ii++ {
+		var tempRow []Polynomial
+		for jj := colStart; jj < colEnd; jj++ {
+			tempRow = append(tempRow, pm[ii][jj].Copy())
+		}
+		out = append(out, tempRow)
+	}
+
+	return out
+}
+
+/*
+Row
+Description:
+
+	Returns row ii of the polynomial matrix as a vector expression.
+*/
+func (pm PolynomialMatrix) Row(ii int) VectorExpression {
+	return MatrixRowTemplate(pm, ii)
+}
+
+/*
+Column
+Description:
+
+	Returns column jj of the polynomial matrix as a vector expression.
+*/
+func (pm PolynomialMatrix) Column(jj int) VectorExpression {
+	return MatrixColumnTemplate(pm, jj)
+}
+
+/*
+ToPolynomialMatrix
+Description:
+
+	Returns a copy of the polynomial matrix. This allows every matrix
+	expression type to be promoted to a PolynomialMatrix in the same way.
+*/
+func (pm PolynomialMatrix) ToPolynomialMatrix() PolynomialMatrix {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var pmOut PolynomialMatrix
+	for _, pmRow := range pm {
+		var pmOutRow []Polynomial
+		for _, polynomial := range pmRow {
+			pmOutRow = append(pmOutRow, polynomial.Copy())
+		}
+		pmOut = append(pmOut, pmOutRow)
+	}
+	return pmOut
+}
+
+/*
+UniqueMonomials
+Description:
+
+	Returns the distinct monomial "shapes" (variables and exponents, ignoring the
+	coefficient) that appear anywhere in the polynomial matrix. Each monomial in
+	the output has coefficient 1.
+*/
+func (pm PolynomialMatrix) UniqueMonomials() []Monomial {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var monomials []Monomial
+	for _, pmRow := range pm {
+		for _, polynomial := range pmRow {
+			monomials = append(monomials, polynomial.Monomials...)
+		}
+	}
+
+	return uniqueMonomialShapes(monomials)
+}
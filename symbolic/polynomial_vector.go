@@ -515,12 +515,22 @@ Description:
 	Returns the derivative of the polynomial vector with respect to the input variable.
 */
 func (pv PolynomialVector) DerivativeWrt(vIn Variable) Expression {
-	// Constants
-	var derivative PolynomialVector = pv
+	// Input Processing
+	err := pv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = vIn.Check()
+	if err != nil {
+		panic(err)
+	}
 
 	// Algorithm
-	for ii, polynomial := range pv {
-		derivative[ii] = polynomial.DerivativeWrt(vIn).(Polynomial)
+	var derivative PolynomialVector
+	for _, polynomial := range pv {
+		dPolynomial, _ := toPolynomial(polynomial.DerivativeWrt(vIn))
+		derivative = append(derivative, dPolynomial)
 	}
 
 	return derivative
@@ -544,6 +554,61 @@ func (pv PolynomialVector) IsConstantVector() bool {
 	return isConstant
 }
 
+/*
+IsConstant
+Description:
+
+	This method returns true if and only if every polynomial in the vector
+	is a constant (i.e., the vector contains no variables).
+*/
+func (pv PolynomialVector) IsConstant() bool {
+	// Input Processing
+	err := pv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	for _, polynomial := range pv {
+		if !polynomial.IsConstant() {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+ToKVector
+Description:
+
+	Converts the polynomial vector into a KVector when every element
+	of the vector is constant. If any element still contains a variable, then an
+	error is returned.
+*/
+func (pv PolynomialVector) ToKVector() (KVector, error) {
+	// Input Processing
+	err := pv.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	var kvOut KVector
+	for ii, polynomial := range pv {
+		if !polynomial.IsConstant() {
+			return nil, fmt.Errorf(
+				"element %v of the polynomial vector is not constant (%v); cannot convert to KVector",
+				ii,
+				polynomial,
+			)
+		}
+		kvOut = append(kvOut, K(polynomial.Simplify().Constant()))
+	}
+
+	return kvOut, nil
+}
+
 /*
 Simplify
 Description:
@@ -651,13 +716,7 @@ func (pv PolynomialVector) SubstituteAccordingTo(subMap map[Variable]Expression)
 	}
 
 	// Algorithm
-	var result VectorExpression = pv
-	for tempVar, tempExpr := range subMap {
-		resultSubbed := result.Substitute(tempVar, tempExpr.(ScalarExpression))
-		result = resultSubbed.(VectorExpression)
-	}
-
-	return result
+	return VectorSubstituteAccordingToTemplate(pv, subMap)
 }
 
 /*
@@ -669,3 +728,199 @@ Description:
 func (pv PolynomialVector) Power(exponent int) Expression {
 	return VectorPowerTemplate(pv, exponent)
 }
+
+/*
+Dot
+Description:
+
+	Computes the dot product of the vector with the vector expression other,
+	i.e., the sum of their element-wise products.
+*/
+func (pv PolynomialVector) Dot(other VectorExpression) ScalarExpression {
+	return VectorDotTemplate(pv, other)
+}
+
+/*
+ElementWisePower
+Description:
+
+	Raises each element of the vector to the power of the input integer.
+	(Power, by contrast, follows the vector semantics of the Expression interface.)
+*/
+func (pv PolynomialVector) ElementWisePower(exponent int) Expression {
+	return VectorElementWisePowerTemplate(pv, exponent)
+}
+
+/*
+SubstituteVector
+Description:
+
+	Substitutes each variable in the vector in with the matching element of
+	replacement.
+*/
+func (pv PolynomialVector) SubstituteVector(in VariableVector, replacement VectorExpression) Expression {
+	// Input Processing
+	err := pv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	subMap, err := substitutionMapOf(in, replacement)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return simplifySubstitution(pv.SubstituteAccordingTo(subMap))
+}
+
+/*
+Outer
+Description:
+
+	Computes the outer product of the vector with other, i.e. the matrix
+	whose (ii,jj) element is pv[ii] * other[jj].
+*/
+func (pv PolynomialVector) Outer(other VectorExpression) MatrixExpression {
+	return VectorOuterTemplate(pv, other)
+}
+
+/*
+Sum
+Description:
+
+	Adds together all of the elements of the vector.
+*/
+func (pv PolynomialVector) Sum() ScalarExpression {
+	return VectorSumTemplate(pv)
+}
+
+/*
+AppendElement
+Description:
+
+	Returns a new polynomial vector containing the elements of pv followed by
+	the scalar expression e (converted to a Polynomial). The receiver may be
+	empty, so that vectors can be built up one element at a time.
+*/
+func (pv PolynomialVector) AppendElement(e ScalarExpression) PolynomialVector {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	eAsP, _ := toPolynomial(e)
+	return pv.Concat(PolynomialVector{eAsP})
+}
+
+/*
+Concat
+Description:
+
+	Returns a new polynomial vector containing the elements of pv followed by
+	the elements of other. Either vector may be empty.
+*/
+func (pv PolynomialVector) Concat(other PolynomialVector) PolynomialVector {
+	// Input Processing
+	for _, part := range []PolynomialVector{pv, other} {
+		for ii, polynomial := range part {
+			err := polynomial.Check()
+			if err != nil {
+				panic(fmt.Errorf("error in polynomial %v: %v", ii, err))
+			}
+		}
+	}
+
+	// Algorithm
+	var out PolynomialVector
+	for _, polynomial := range pv {
+		out = append(out, polynomial.Copy())
+	}
+	for _, polynomial := range other {
+		out = append(out, polynomial.Copy())
+	}
+
+	return out
+}
+
+/*
+Slice
+Description:
+
+	Returns a new vector containing the elements of the polynomial vector with
+	indices in the half-open range [start, end).
+*/
+func (pv PolynomialVector) Slice(start, end int) VectorExpression {
+	// Input Processing
+	err := pv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = checkSliceRange(start, end, pv)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out PolynomialVector
+	for ii := start; ii < end; ii++ {
+		out = append(out, pv[ii].Copy())
+	}
+
+	return out
+}
+
+/*
+UniqueMonomials
+Description:
+
+	Returns the distinct monomial "shapes" (variables and exponents, ignoring the
+	coefficient) that appear anywhere in the polynomial vector. Each monomial in
+	the output has coefficient 1.
+*/
+func (pv PolynomialVector) UniqueMonomials() []Monomial {
+	// Input Processing
+	err := pv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var monomials []Monomial
+	for _, polynomial := range pv {
+		monomials = append(monomials, polynomial.Monomials...)
+	}
+
+	return uniqueMonomialShapes(monomials)
+}
+
+/*
+uniqueMonomialShapes
+Description:
+
+	Returns one monomial (with coefficient 1) for each distinct combination of
+	variables and exponents in monomials, in order of first appearance.
+*/
+func uniqueMonomialShapes(monomials []Monomial) []Monomial {
+	var shapes []Monomial
+	for _, monomial := range monomials {
+		found := false
+		for _, shape := range shapes {
+			if shape.MatchesFormOf(monomial) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			shape := monomial.Copy()
+			shape.Coefficient = 1.0
+			shapes = append(shapes, shape)
+		}
+	}
+
+	return shapes
+}
@@ -1,8 +1,17 @@
 package symbolic
 
-// ScalarConstraint represnts a linear constraint of the form x <= y, x >= y, or
-// x == y. ScalarConstraint uses a left and right hand side expressions along with a
-// constraint sense (<=, >=, ==) to represent a generalized linear constraint
+import (
+	"fmt"
+	"math"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ScalarConstraint represnts a constraint of the form x <= y, x >= y, x == y,
+// x < y or x > y. ScalarConstraint uses a left and right hand side expressions along
+// with a constraint sense (<=, >=, ==, <, >) to represent a generalized constraint,
+// which may be nonlinear (see IsLinear).
 type ScalarConstraint struct {
 	LeftHandSide  ScalarExpression
 	RightHandSide ScalarExpression
@@ -32,29 +41,78 @@ func (sc ScalarConstraint) IsLinear() bool {
 Simplify
 Description:
 
-	Moves all of the variables of the ScalarConstraint to its
-	left hand side.
+	Puts the ScalarConstraint into the canonical form
+		(polynomial) sense 0
+	by subtracting the right hand side from the left hand side and
+	simplifying the resulting polynomial. For example, x + 1 <= y + 2
+	becomes x - y - 1 <= 0.
 */
 func (sc ScalarConstraint) Simplify() ScalarConstraint {
-	// Create LHS
-	newLHS := sc.LeftHandSide
-
-	// If RHS is a constant, then simply return the constraint
-	if _, ok := sc.RightHandSide.(K); ok {
-		return sc
+	// Input Processing
+	err := sc.Check()
+	if err != nil {
+		panic(err)
 	}
 
 	// Algorithm
-	newLHS = newLHS.Minus(sc.RightHandSide).Plus(
-		sc.RightHandSide.Constant(),
-	).(ScalarExpression) // This should be a scalar expression
-
 	return ScalarConstraint{
-		LeftHandSide:  newLHS,
-		RightHandSide: K(sc.RightHandSide.Constant()),
+		LeftHandSide:  sc.difference(),
+		RightHandSide: K(0.0),
 		Sense:         sc.Sense,
 	}
+}
+
+/*
+Triviality
+Description:
+
+	Determines whether or not the constraint is trivial. After simplifying
+	the difference between the two sides of the constraint, if no variables
+	remain, then the constraint is either always satisfied (alwaysTrue) or never
+	satisfied (alwaysFalse). If variables remain, then both outputs are false.
+*/
+func (sc ScalarConstraint) Triviality() (alwaysTrue bool, alwaysFalse bool) {
+	// Input Processing
+	err := sc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Compute the simplified difference LHS - RHS
+	lhsAsP, _ := toPolynomial(sc.LeftHandSide)
+	rhsAsP, _ := toPolynomial(sc.RightHandSide)
+	difference := lhsAsP.Plus(rhsAsP.Multiply(-1.0)).(Polynomial)
+
+	// Algorithm
+	constant := 0.0
+	for _, monomial := range difference.Monomials {
+		switch {
+		case monomial.Coefficient == 0.0:
+			// Terms that cancelled out do not matter
+			continue
+		case !monomial.IsConstant():
+			// A variable remains, so the constraint is not trivial
+			return false, false
+		default:
+			constant += monomial.Coefficient
+		}
+	}
+
+	var satisfied bool
+	switch sc.Sense {
+	case SenseLessThanEqual:
+		satisfied = constant <= 0.0
+	case SenseGreaterThanEqual:
+		satisfied = constant >= 0.0
+	case SenseLessThan:
+		satisfied = constant < 0.0
+	case SenseGreaterThan:
+		satisfied = constant > 0.0
+	case SenseEqual:
+		satisfied = constant == 0.0
+	}
 
+	return satisfied, !satisfied
 }
 
 /*
@@ -95,3 +153,196 @@ func (sc ScalarConstraint) Check() error {
 	// All Checks Passed!
 	return nil
 }
+
+/*
+LinearCoeff
+Description:
+
+	Writes the (linear) constraint in the form a^T x (sense) b, where x is the
+	vector of variables wrt, and returns the coefficient vector a. All variable
+	terms are moved to the left hand side and all constants to the right.
+	Panics if the constraint is nonlinear or contains a variable that is not in wrt.
+*/
+func (sc ScalarConstraint) LinearCoeff(wrt []Variable) mat.VecDense {
+	// Input Processing
+	err := sc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if len(wrt) == 0 {
+		panic(
+			smErrors.CanNotGetLinearCoeffOfConstantError{Expression: sc.LeftHandSide},
+		)
+	}
+
+	// Algorithm
+	row, _, err := linearRowOf(sc.difference(), wrt)
+	if err != nil {
+		panic(fmt.Errorf("LinearCoeff: %v", err))
+	}
+
+	return *mat.NewVecDense(len(wrt), row)
+}
+
+/*
+ConstantRHS
+Description:
+
+	Writes the (linear) constraint in the form a^T x (sense) b and returns the
+	constant b. All variable terms are moved to the left hand side and all
+	constants to the right. Panics if the constraint is nonlinear.
+*/
+func (sc ScalarConstraint) ConstantRHS() float64 {
+	// Input Processing
+	err := sc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	difference := sc.difference()
+	if difference.Degree() > 1 {
+		panic(
+			fmt.Errorf(
+				"ConstantRHS: constraint has degree %v; only linear constraints are supported",
+				difference.Degree(),
+			),
+		)
+	}
+
+	return -difference.Constant()
+}
+
+/*
+difference
+Description:
+
+	Returns the simplified polynomial LHS - RHS of the constraint.
+*/
+func (sc ScalarConstraint) difference() Polynomial {
+	lhsAsP, _ := toPolynomial(sc.LeftHandSide)
+	rhsAsP, _ := toPolynomial(sc.RightHandSide)
+	return lhsAsP.Plus(rhsAsP.Multiply(-1.0)).(Polynomial).Simplify()
+}
+
+/*
+Flip
+Description:
+
+	Returns the same constraint with its two sides swapped, e.g. x >= 3
+	becomes 3 <= x. Equality constraints remain equalities.
+*/
+func (sc ScalarConstraint) Flip() ScalarConstraint {
+	// Input Processing
+	err := sc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return ScalarConstraint{
+		LeftHandSide:  sc.RightHandSide,
+		RightHandSide: sc.LeftHandSide,
+		Sense:         sc.Sense.Reverse(),
+	}
+}
+
+/*
+AsLessEq
+Description:
+
+	Rewrites the inequality constraint in the form expr <= b, where all variable
+	terms are in expr and b is a constant. Strict inequalities are rewritten in the
+	form expr < b instead. Panics for equality constraints, which can not be
+	written as a single <= constraint.
+*/
+func (sc ScalarConstraint) AsLessEq() ScalarConstraint {
+	// Input Processing
+	err := sc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if sc.Sense == SenseEqual {
+		panic(
+			fmt.Errorf("AsLessEq: equality constraint %v can not be written as a single <= constraint", sc),
+		)
+	}
+
+	// Algorithm
+	difference := sc.difference()
+	if sc.Sense == SenseGreaterThanEqual || sc.Sense == SenseGreaterThan {
+		difference = difference.Multiply(-1.0).(Polynomial)
+	}
+
+	var sense ConstrSense = SenseLessThanEqual
+	if sc.Sense == SenseLessThan || sc.Sense == SenseGreaterThan {
+		sense = SenseLessThan
+	}
+
+	constant := difference.Constant()
+	expr := difference.Plus(-constant).(Polynomial).Simplify()
+
+	return ScalarConstraint{
+		LeftHandSide:  expr,
+		RightHandSide: K(-constant),
+		Sense:         sense,
+	}
+}
+
+/*
+IsSatisfiedAt
+Description:
+
+	Evaluates both sides of the constraint at the values in assignment and
+	reports whether the constraint holds there. The optional tolerance (default 0)
+	is the amount by which a non-strict constraint (<=, >= or =) may be violated
+	and still be considered satisfied; it is mostly useful for equality constraints.
+	Strict constraints (< and >) ignore the tolerance, so x < 5 is never satisfied
+	at x = 5.
+	An error is returned if any variable of the constraint is missing from assignment.
+*/
+func (sc ScalarConstraint) IsSatisfiedAt(assignment map[Variable]float64, tolIn ...float64) (bool, error) {
+	// Input Processing
+	err := sc.Check()
+	if err != nil {
+		return false, err
+	}
+
+	var tol float64
+	switch len(tolIn) {
+	case 0:
+		tol = 0.0
+	case 1:
+		tol = tolIn[0]
+	default:
+		panic(fmt.Errorf("Too many inputs provided to IsSatisfiedAt() method."))
+	}
+
+	// Algorithm
+	lhsValue, err := sc.LeftHandSide.Eval(assignment)
+	if err != nil {
+		return false, err
+	}
+
+	rhsValue, err := sc.RightHandSide.Eval(assignment)
+	if err != nil {
+		return false, err
+	}
+
+	switch sc.Sense {
+	case SenseLessThanEqual:
+		return lhsValue <= rhsValue+tol, nil
+	case SenseGreaterThanEqual:
+		return lhsValue >= rhsValue-tol, nil
+	case SenseLessThan:
+		return lhsValue < rhsValue, nil
+	case SenseGreaterThan:
+		return lhsValue > rhsValue, nil
+	case SenseEqual:
+		return math.Abs(lhsValue-rhsValue) <= tol, nil
+	}
+
+	return false, fmt.Errorf("IsSatisfiedAt: unexpected constraint sense %v", sc.Sense)
+}
@@ -2,6 +2,7 @@ package symbolic
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
@@ -77,6 +78,9 @@ type ScalarExpression interface {
 
 	// At returns the value at the given row and column index
 	At(ii, jj int) ScalarExpression
+
+	// Eval evaluates the expression using the values in assignment
+	Eval(assignment map[Variable]float64) (float64, error)
 }
 
 // NewExpr returns a new expression with a single additive constant value, c,
@@ -176,3 +180,271 @@ func ScalarPowerTemplate(base ScalarExpression, exponent int) Expression {
 
 	return result
 }
+
+/*
+AreEqual
+Description:
+
+	Determines whether or not the two scalar expressions are equal, up to
+	the tolerance tol. The two expressions are converted to polynomials and
+	are considered equal if every coefficient of their (simplified) difference
+	has magnitude at most tol.
+*/
+func AreEqual(left, right ScalarExpression, tol float64) bool {
+	// Input Processing
+	err := left.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = right.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	leftAsP, _ := toPolynomial(left)
+	rightAsP, _ := toPolynomial(right)
+
+	difference := leftAsP.Plus(rightAsP.Multiply(-1.0)).(Polynomial)
+	for _, monomial := range difference.Monomials {
+		if math.Abs(monomial.Coefficient) > tol {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Laplacian
+Description:
+
+	Computes the Laplacian of the scalar expression e with respect to the
+	variables in wrt, i.e., the sum of the unmixed second partial derivatives
+		d^2 e / d wrt[0]^2 + ... + d^2 e / d wrt[n-1]^2.
+	The result is simplified and returned as a K if it is constant.
+*/
+func Laplacian(e ScalarExpression, wrt []Variable) ScalarExpression {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if len(wrt) == 0 {
+		panic(
+			fmt.Errorf("Laplacian: There must be at least one variable to differentiate with respect to; received 0"),
+		)
+	}
+
+	// Algorithm
+	result := K(0.0).ToPolynomial()
+	for _, v := range wrt {
+		firstDerivative := e.DerivativeWrt(v).(ScalarExpression)
+		secondDerivative, _ := toPolynomial(firstDerivative.DerivativeWrt(v))
+		result = result.Plus(secondDerivative).(Polynomial)
+	}
+
+	result = result.Simplify()
+	if result.IsConstant() {
+		return K(result.Constant())
+	}
+
+	return result
+}
+
+/*
+Gradient
+Description:
+
+	Computes the gradient of the scalar expression e with respect to the
+	variables in wrt. The result is a column vector whose i-th entry is the
+	derivative of e with respect to wrt[i]. A constant e yields a zero KVector.
+*/
+func Gradient(e ScalarExpression, wrt []Variable) VectorExpression {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if len(wrt) == 0 {
+		panic(
+			fmt.Errorf("Gradient: There must be at least one variable to differentiate with respect to; received 0"),
+		)
+	}
+
+	for _, v := range wrt {
+		err = v.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	if len(e.Variables()) == 0 {
+		return VecDenseToKVector(ZerosVector(len(wrt)))
+	}
+
+	var result []ScalarExpression
+	for _, v := range wrt {
+		result = append(result, e.DerivativeWrt(v).(ScalarExpression))
+	}
+
+	return ConcretizeVectorExpression(result)
+}
+
+/*
+Hessian
+Description:
+
+	Computes the Hessian of the scalar expression e with respect to the
+	variables in wrt. The (i,j) entry of the result is the second derivative
+	of e with respect to wrt[i] and wrt[j]. Only the upper triangle is
+	computed, so the result is always symmetric.
+	The result is a KMatrix when every entry is constant (e.g., when e is
+	quadratic) and a PolynomialMatrix otherwise.
+*/
+func Hessian(e ScalarExpression, wrt []Variable) MatrixExpression {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if len(wrt) == 0 {
+		panic(
+			fmt.Errorf("Hessian: There must be at least one variable to differentiate with respect to; received 0"),
+		)
+	}
+
+	for _, v := range wrt {
+		err = v.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Constants
+	n := len(wrt)
+
+	// Algorithm
+	result := make(PolynomialMatrix, n)
+	for ii := range result {
+		result[ii] = make([]Polynomial, n)
+	}
+
+	allConstant := true
+	for ii := 0; ii < n; ii++ {
+		firstDerivative := e.DerivativeWrt(wrt[ii]).(ScalarExpression)
+		for jj := ii; jj < n; jj++ {
+			secondDerivative, _ := toPolynomial(firstDerivative.DerivativeWrt(wrt[jj]))
+			secondDerivative = secondDerivative.Simplify()
+			allConstant = allConstant && secondDerivative.IsConstant()
+
+			result[ii][jj] = secondDerivative
+			result[jj][ii] = secondDerivative.Copy()
+		}
+	}
+
+	if allConstant {
+		var kmOut KMatrix
+		for _, row := range result {
+			var kmRow []K
+			for _, p := range row {
+				kmRow = append(kmRow, K(p.Constant()))
+			}
+			kmOut = append(kmOut, kmRow)
+		}
+		return kmOut
+	}
+
+	return result
+}
+
+/*
+QuadraticApproximation
+Description:
+
+	Computes the second-order Taylor expansion of the scalar expression e around
+	the point x0 given in point, i.e.,
+		f(x0) + grad f(x0)^T (x - x0) + 0.5 (x - x0)^T H(x0) (x - x0),
+	as a polynomial in the variables of e. An error is returned if any variable
+	of e is missing from point.
+*/
+func QuadraticApproximation(e ScalarExpression, point map[Variable]float64) (Polynomial, error) {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		return Polynomial{}, err
+	}
+
+	// Evaluate the expression at the point (this catches missing variables)
+	f0, err := e.Eval(point)
+	if err != nil {
+		return Polynomial{}, err
+	}
+
+	vars := e.Variables()
+	if len(vars) == 0 {
+		return K(f0).ToPolynomial(), nil
+	}
+
+	// Constants
+	var deltas []Polynomial
+	for _, v := range vars {
+		x0, _ := v.Eval(point)
+		deltas = append(deltas, v.Minus(x0).(Polynomial))
+	}
+
+	// Algorithm
+	gradient := Gradient(e, vars)
+	hessian := Hessian(e, vars)
+
+	approximation := K(f0).ToPolynomial()
+	for ii := range vars {
+		gII, err := gradient.AtVec(ii).Eval(point)
+		if err != nil {
+			return Polynomial{}, err
+		}
+		approximation = approximation.Plus(deltas[ii].Multiply(gII)).(Polynomial)
+
+		for jj := range vars {
+			hIJ, err := hessian.At(ii, jj).Eval(point)
+			if err != nil {
+				return Polynomial{}, err
+			}
+			if hIJ == 0.0 {
+				continue
+			}
+
+			term := deltas[ii].Multiply(deltas[jj]).(Polynomial).Multiply(0.5 * hIJ)
+			approximation = approximation.Plus(term).(Polynomial)
+		}
+	}
+
+	return approximation.Simplify(), nil
+}
+
+/*
+collapseConstant
+Description:
+
+	Returns the scalar expression se as a K if it is a constant Monomial or
+	Polynomial. Otherwise, se is returned unchanged.
+*/
+func collapseConstant(se ScalarExpression) ScalarExpression {
+	switch concreteSE := se.(type) {
+	case Monomial:
+		if concreteSE.IsConstant() {
+			return K(concreteSE.Coefficient)
+		}
+	case Polynomial:
+		if concreteSE.IsConstant() {
+			return K(concreteSE.Constant())
+		}
+	}
+
+	return se
+}
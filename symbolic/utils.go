@@ -165,7 +165,8 @@ Description:
 	This function verifies that the input substitution map is valid. i.e., the map should contain:
 		1. Valid variables as keys
 		2. Valid expressions as values
-		3. The values should also be scalar expressions
+		3. The values should also be scalar expressions (or 1 x 1 vector/matrix
+		   expressions, which are treated as scalars)
 */
 func CheckSubstitutionMap(subMap map[Variable]Expression) error {
 	var err error
@@ -192,8 +193,8 @@ func CheckSubstitutionMap(subMap map[Variable]Expression) error {
 			)
 		}
 
-		// Verify that the value is a scalar expression
-		if !IsScalarExpression(tempExpr) {
+		// Verify that the value is a scalar expression (or a 1 x 1 vector/matrix expression)
+		if dims := tempExpr.Dims(); !IsScalarExpression(tempExpr) && (dims[0] != 1 || dims[1] != 1) {
 			return fmt.Errorf(
 				"value %v in the substitution map[%v] is not a scalar expression (received %T)",
 				tempExpr,
@@ -206,3 +207,89 @@ func CheckSubstitutionMap(subMap map[Variable]Expression) error {
 	// All checks passed
 	return nil
 }
+
+/*
+substitutionMapOf
+Description:
+
+	Creates the substitution map which replaces each variable in the vector in
+	with the matching element of replacement. An error is returned if the two
+	vectors have different lengths or if in contains the same variable twice.
+	The SubstituteVector methods apply this map with SubstituteAccordingTo, which
+	replaces all of the variables at once, so replacement may refer to the
+	variables in in (e.g., to permute them). The methods of the non-constant
+	types then pass the result through simplifySubstitution.
+*/
+func substitutionMapOf(in VariableVector, replacement VectorExpression) (map[Variable]Expression, error) {
+	// Input Processing
+	err := in.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	err = replacement.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Len() != replacement.Len() {
+		return nil, smErrors.DimensionError{
+			Operation: "SubstituteVector",
+			Arg1:      in,
+			Arg2:      replacement,
+		}
+	}
+
+	// Algorithm
+	subMap := make(map[Variable]Expression)
+	for ii, v := range in {
+		if _, tf := subMap[v]; tf {
+			return nil, fmt.Errorf(
+				"variable %v appears more than once in the vector of variables to substitute",
+				v,
+			)
+		}
+		subMap[v] = replacement.AtVec(ii)
+	}
+
+	return subMap, nil
+}
+
+/*
+simplifySubstitution
+Description:
+
+	Simplifies the result of a substitution when it is a polynomial (or a vector
+	or matrix of polynomials), so that terms which cancel (or the zero terms left
+	over from the substitution) are dropped.
+*/
+func simplifySubstitution(e Expression) Expression {
+	switch concreteE := e.(type) {
+	case Polynomial:
+		return concreteE.Simplify()
+	case PolynomialVector:
+		return concreteE.Simplify()
+	case PolynomialMatrix:
+		return concreteE.Simplify()
+	}
+	return e
+}
+
+/*
+scalarSubstitutionMap
+Description:
+
+	Returns a copy of the (already checked) substitution map where each 1 x 1
+	vector or matrix expression value is replaced by its single scalar element.
+*/
+func scalarSubstitutionMap(subMap map[Variable]Expression) map[Variable]Expression {
+	out := make(map[Variable]Expression)
+	for tempVar, tempExpr := range subMap {
+		if IsScalarExpression(tempExpr) {
+			out[tempVar] = tempExpr
+		} else {
+			out[tempVar] = tempExpr.At(0, 0)
+		}
+	}
+	return out
+}
@@ -2,6 +2,8 @@ package symbolic
 
 import (
 	"fmt"
+	"math"
+	"sort"
 
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
@@ -9,12 +11,15 @@ import (
 
 // Var represnts a variable in a optimization problem. The variable is
 // identified with an uint64.
+// If IsParameter is true, then the variable represents a fixed parameter
+// (i.e., data) rather than a decision variable.
 type Variable struct {
-	ID    uint64
-	Lower float64
-	Upper float64
-	Type  VarType
-	Name  string
+	ID          uint64
+	Lower       float64
+	Upper       float64
+	Type        VarType
+	Name        string
+	IsParameter bool
 }
 
 /*
@@ -257,8 +262,8 @@ type VarType byte
 // to Gurobi's encoding.
 const (
 	Continuous VarType = 'C'
-	Binary             = 'B'
-	Integer            = 'I'
+	Binary     VarType = 'B'
+	Integer    VarType = 'I'
 )
 
 /*
@@ -266,7 +271,9 @@ UniqueVars
 Description:
 
 	This function creates a slice of unique variables from the slice given in
-	varsIn
+	varsIn. The output is sorted in ascending order of variable ID, so that the
+	order of the variables (e.g., in Variables() or in the columns of LinearCoeff)
+	does not depend on the order in which they appear in an expression.
 */
 func UniqueVars(varsIn []Variable) []Variable {
 	// Constants
@@ -279,10 +286,19 @@ func UniqueVars(varsIn []Variable) []Variable {
 		}
 	}
 
+	sort.Sort(variablesByID(varsOut))
+
 	return varsOut
 
 }
 
+// variablesByID implements sort.Interface for sorting a slice of variables by ID.
+type variablesByID []Variable
+
+func (vs variablesByID) Len() int             { return len(vs) }
+func (vs variablesByID) Less(ii, jj int) bool { return vs[ii].ID < vs[jj].ID }
+func (vs variablesByID) Swap(ii, jj int)      { vs[ii], vs[jj] = vs[jj], vs[ii] }
+
 /*
 Multiply
 Description:
@@ -392,6 +408,14 @@ func (v Variable) Check() error {
 		)
 	}
 
+	// Check that binary variables are bounded within [0, 1]
+	if v.Type == Binary && (v.Lower < 0.0 || v.Upper > 1.0) {
+		return fmt.Errorf(
+			"binary variable must have bounds within [0, 1]; received [%v, %v].",
+			v.Lower, v.Upper,
+		)
+	}
+
 	// If nothing was thrown, then return nil!
 	return nil
 }
@@ -403,43 +427,150 @@ func (v Variable) Transpose() Expression {
 /*
 NewVariable
 Description:
+
+	Creates a new continuous variable. The optional arguments may contain an
+	environment (*Environment) and a pair of bounds (float64, int or K) given as
+	lower then upper, e.g. NewVariable(0.0, 10.0) or NewVariable(&env, 0.0, 10.0).
+	Without bounds, the variable is unbounded. See NewBoundedVariable.
 */
-func NewVariable(envs ...*Environment) Variable {
-	return NewContinuousVariable(envs...)
+func NewVariable(args ...interface{}) Variable {
+	// Input Processing
+	var envs []*Environment
+	var bounds []float64
+	for _, arg := range args {
+		switch concreteArg := arg.(type) {
+		case *Environment:
+			envs = append(envs, concreteArg)
+		case float64:
+			bounds = append(bounds, concreteArg)
+		case int:
+			bounds = append(bounds, float64(concreteArg))
+		case K:
+			bounds = append(bounds, float64(concreteArg))
+		default:
+			panic(
+				smErrors.UnsupportedInputError{
+					FunctionName: "NewVariable",
+					Input:        arg,
+				},
+			)
+		}
+	}
+
+	// Algorithm
+	switch len(bounds) {
+	case 0:
+		return NewContinuousVariable(envs...)
+	case 2:
+		return NewBoundedVariable(bounds[0], bounds[1], envs...)
+	default:
+		panic(
+			fmt.Errorf(
+				"NewVariable: expected either 0 or 2 bounds (lower and upper); received %v",
+				len(bounds),
+			),
+		)
+	}
 }
 
 /*
-NewContinuousVariable
+NewBoundedVariable
 Description:
 
-	Creates a new continuous variable.
+	Creates a new continuous variable with the bounds lower <= x <= upper.
+	Either bound may be given as +/- Infinity (or +/- math.Inf) to leave that
+	side unbounded.
 */
-func NewContinuousVariable(envs ...*Environment) Variable {
-	// Constants
-
+func NewBoundedVariable(lower, upper float64, envs ...*Environment) Variable {
 	// Input Processing
 	var currentEnv = &BackgroundEnvironment
 	switch len(envs) {
+	case 0:
 	case 1:
 		currentEnv = envs[0]
+	default:
+		panic(
+			fmt.Errorf("Too many inputs provided to NewBoundedVariable() method"),
+		)
 	}
 
-	// Get New Index
-	nextIdx := len(currentEnv.Variables)
+	if lower >= upper {
+		panic(
+			fmt.Errorf(
+				"lower bound (%v) of variable must be less than upper bound (%v).",
+				lower, upper,
+			),
+		)
+	}
+
+	// Algorithm
+	variableOut := NewContinuousVariable(currentEnv)
+	variableOut.Lower = lower
+	variableOut.Upper = upper
+
+	// Update the copy of the variable stored in the environment
+	currentEnv.updateVariable(variableOut)
+
+	return variableOut
+}
 
-	// Create variable
-	variableOut := Variable{
-		ID:    uint64(nextIdx),
-		Lower: float64(-Infinity),
-		Upper: float64(+Infinity),
-		Type:  Continuous,
-		Name:  fmt.Sprintf("x_%v", nextIdx),
+/*
+NewNamedVariable
+Description:
+
+	Creates a new continuous variable with the given name. The name is only used
+	when printing the variable; variables are still identified by their ID, so
+	two variables may share a name.
+*/
+func NewNamedVariable(name string, envs ...*Environment) Variable {
+	// Input Processing
+	var currentEnv = &BackgroundEnvironment
+	switch len(envs) {
+	case 0:
+	case 1:
+		currentEnv = envs[0]
+	default:
+		panic(
+			fmt.Errorf("Too many inputs provided to NewNamedVariable() method"),
+		)
 	}
 
-	// Update environment
-	currentEnv.Variables = append(currentEnv.Variables, variableOut)
+	// Algorithm
+	variableOut := NewContinuousVariable(currentEnv)
+	variableOut.Name = name
+
+	// Update the copy of the variable stored in the environment
+	currentEnv.updateVariable(variableOut)
 
 	return variableOut
+}
+
+/*
+NewContinuousVariable
+Description:
+
+	Creates a new continuous variable.
+*/
+func NewContinuousVariable(envs ...*Environment) Variable {
+	// Constants
+
+	// Input Processing
+	var currentEnv = &BackgroundEnvironment
+	switch len(envs) {
+	case 1:
+		currentEnv = envs[0]
+	}
+
+	// Create variable and add it to the environment
+	return currentEnv.addVariable(func(id uint64) Variable {
+		return Variable{
+			ID:    id,
+			Lower: float64(-Infinity),
+			Upper: float64(+Infinity),
+			Type:  Continuous,
+			Name:  fmt.Sprintf("x_%v", id),
+		}
+	})
 
 }
 
@@ -459,23 +590,47 @@ func NewBinaryVariable(envs ...*Environment) Variable {
 		currentEnv = envs[0]
 	}
 
-	// Get New Index
-	nextIdx := len(currentEnv.Variables)
-
 	// Get New Variable Object and add it to environment
-	variableOut := Variable{
-		ID:    uint64(nextIdx),
-		Lower: 0.0,
-		Upper: 1.0,
-		Type:  Binary,
-		Name:  fmt.Sprintf("x_%v", nextIdx),
-	}
+	return currentEnv.addVariable(func(id uint64) Variable {
+		return Variable{
+			ID:    id,
+			Lower: 0.0,
+			Upper: 1.0,
+			Type:  Binary,
+			Name:  fmt.Sprintf("x_%v", id),
+		}
+	})
 
-	// Update env
-	currentEnv.Variables = append(currentEnv.Variables, variableOut)
+}
 
-	return variableOut
+/*
+NewParameter
+Description:
+
+	Creates a new parameter. A parameter is a continuous variable that represents
+	fixed data (rather than a decision variable) in a parametric optimization problem.
+*/
+func NewParameter(envs ...*Environment) Variable {
+	// Constants
 
+	// Input Processing
+	var currentEnv = &BackgroundEnvironment
+	switch len(envs) {
+	case 1:
+		currentEnv = envs[0]
+	}
+
+	// Create parameter and add it to the environment
+	return currentEnv.addVariable(func(id uint64) Variable {
+		return Variable{
+			ID:          id,
+			Lower:       float64(-Infinity),
+			Upper:       float64(+Infinity),
+			Type:        Continuous,
+			Name:        fmt.Sprintf("p_%v", id),
+			IsParameter: true,
+		}
+	})
 }
 
 /*
@@ -546,9 +701,13 @@ func (v Variable) Degree() int {
 String
 Description:
 
-	This method returns a string representation of the variable.
+	This method returns a string representation of the variable: its name,
+	or x_<ID> if the variable has no name.
 */
 func (v Variable) String() string {
+	if v.Name == "" {
+		return fmt.Sprintf("x_%v", v.ID)
+	}
 	return v.Name
 }
 
@@ -601,6 +760,8 @@ func (v Variable) SubstituteAccordingTo(subMap map[Variable]Expression) Expressi
 		panic(err)
 	}
 
+	subMap = scalarSubstitutionMap(subMap)
+
 	// Algorithm
 	if e, ok := subMap[v]; ok {
 		return e
@@ -647,3 +808,101 @@ func (v Variable) At(ii, jj int) ScalarExpression {
 	// Algorithm
 	return v
 }
+
+/*
+Eval
+Description:
+
+	Evaluates the variable according to the values in assignment.
+	Variables are matched by ID. An error is returned if v is missing
+	from assignment.
+*/
+func (v Variable) Eval(assignment map[Variable]float64) (float64, error) {
+	// Input Processing
+	err := v.Check()
+	if err != nil {
+		return 0.0, err
+	}
+
+	// Algorithm
+	if value, tf := assignment[v]; tf {
+		return value, nil
+	}
+
+	for vII, value := range assignment {
+		if vII.ID == v.ID {
+			return value, nil
+		}
+	}
+
+	return 0.0, fmt.Errorf("variable %v is missing from the assignment", v)
+}
+
+/*
+SubstituteVector
+Description:
+
+	Substitutes each variable in the vector in with the matching element of
+	replacement.
+*/
+func (v Variable) SubstituteVector(in VariableVector, replacement VectorExpression) Expression {
+	// Input Processing
+	err := v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	subMap, err := substitutionMapOf(in, replacement)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return simplifySubstitution(v.SubstituteAccordingTo(subMap))
+}
+
+/*
+BoundConstraints
+Description:
+
+	Returns the constraints x >= Lower and x <= Upper implied by the bounds
+	of the variable. Bounds at (or beyond) +/- Infinity are not included, so an
+	unbounded variable gives no constraints.
+*/
+func (v Variable) BoundConstraints() []ScalarConstraint {
+	// Input Processing
+	err := v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var constraints []ScalarConstraint
+	if isFiniteBound(v.Lower) {
+		constraints = append(constraints, ScalarConstraint{
+			LeftHandSide:  v,
+			RightHandSide: K(v.Lower),
+			Sense:         SenseGreaterThanEqual,
+		})
+	}
+
+	if isFiniteBound(v.Upper) {
+		constraints = append(constraints, ScalarConstraint{
+			LeftHandSide:  v,
+			RightHandSide: K(v.Upper),
+			Sense:         SenseLessThanEqual,
+		})
+	}
+
+	return constraints
+}
+
+/*
+isFiniteBound
+Description:
+
+	Returns true if the bound b is strictly between -Infinity and +Infinity.
+*/
+func isFiniteBound(b float64) bool {
+	return math.Abs(b) < float64(Infinity)
+}
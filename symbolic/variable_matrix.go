@@ -156,6 +156,18 @@ func (vm VariableMatrix) Plus(e interface{}) Expression {
 
 		return vm.Plus(DenseToKMatrix(kmOut))
 
+	case Variable, Monomial, Polynomial:
+		// Add the scalar to each element of the matrix
+		var pmOut PolynomialMatrix
+		for _, vmRow := range vm {
+			var pmRow []Polynomial
+			for _, v := range vmRow {
+				sumAsP, _ := toPolynomial(v.Plus(right))
+				pmRow = append(pmRow, sumAsP)
+			}
+			pmOut = append(pmOut, pmRow)
+		}
+		return pmOut
 	case KMatrix:
 		// Create a new matrix of polynomials.
 		var pmOut PolynomialMatrix
@@ -167,6 +179,19 @@ func (vm VariableMatrix) Plus(e interface{}) Expression {
 			pmOut = append(pmOut, pmRow)
 		}
 		return pmOut
+	case VariableMatrix, MonomialMatrix, PolynomialMatrix:
+		// Add the matrices element-wise
+		rightAsME, _ := ToMatrixExpression(right)
+		var pmOut PolynomialMatrix
+		for ii, vmRow := range vm {
+			var pmRow []Polynomial
+			for jj, v := range vmRow {
+				sumAsP, _ := toPolynomial(v.Plus(rightAsME.At(ii, jj)))
+				pmRow = append(pmRow, sumAsP)
+			}
+			pmOut = append(pmOut, pmRow)
+		}
+		return pmOut
 	}
 
 	// panic if the type is not recognized
@@ -384,6 +409,28 @@ func (vm VariableMatrix) Multiply(e interface{}) Expression {
 			}
 			return result
 		}
+	case VariableMatrix, MonomialMatrix, PolynomialMatrix:
+		// Collect dimensions
+		rightAsME, _ := ToMatrixExpression(right)
+		nResultRows, nResultCols := vm.Dims()[0], rightAsME.Dims()[1]
+
+		// Compute each element as the inner product of a row of vm and a column of right
+		var result [][]ScalarExpression
+		for ii := 0; ii < nResultRows; ii++ {
+			var resultRow []ScalarExpression
+			for jj := 0; jj < nResultCols; jj++ {
+				var productIJ Polynomial = K(0).ToPolynomial()
+				for kk := 0; kk < vm.Dims()[1]; kk++ {
+					productIJ = productIJ.Plus(
+						vm[ii][kk].Multiply(rightAsME.At(kk, jj)),
+					).(Polynomial)
+				}
+				resultRow = append(resultRow, productIJ.Simplify())
+			}
+			result = append(result, resultRow)
+		}
+
+		return ConcretizeExpression(result)
 	}
 
 	// panic if the type is not recognized
@@ -425,6 +472,37 @@ func (vm VariableMatrix) Transpose() Expression {
 	return vmOut
 }
 
+/*
+AsColumn
+Description:
+
+	Returns the variable matrix as a VariableVector (i.e., a column vector).
+	An error is returned unless the matrix has exactly one column.
+*/
+func (vm VariableMatrix) AsColumn() (VariableVector, error) {
+	// Input Processing
+	err := vm.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	nCols := vm.Dims()[1]
+	if nCols != 1 {
+		return nil, fmt.Errorf(
+			"cannot convert a variable matrix with %v columns into a column vector; expected 1 column",
+			nCols,
+		)
+	}
+
+	// Algorithm
+	var vvOut VariableVector
+	for _, vmRow := range vm {
+		vvOut = append(vvOut, vmRow[0])
+	}
+
+	return vvOut, nil
+}
+
 /*
 Comparison
 Description:
@@ -747,13 +825,7 @@ func (vm VariableMatrix) SubstituteAccordingTo(subMap map[Variable]Expression) E
 	}
 
 	// Algorithm
-	var out MatrixExpression = vm
-	for v, e := range subMap {
-		outSubbed := out.Substitute(v, e.(ScalarExpression))
-		out = outSubbed.(MatrixExpression)
-	}
-
-	return out
+	return MatrixSubstituteAccordingToTemplate(vm, subMap)
 }
 
 /*
@@ -765,3 +837,76 @@ Description:
 func (vm VariableMatrix) Power(exponent int) Expression {
 	return MatrixPowerTemplate(vm, exponent)
 }
+
+/*
+ElementWisePower
+Description:
+
+	Raises each element of the matrix to the power of the input integer.
+	(Power, by contrast, follows the matrix semantics of the Expression interface.)
+*/
+func (vm VariableMatrix) ElementWisePower(exponent int) Expression {
+	return MatrixElementWisePowerTemplate(vm, exponent)
+}
+
+/*
+Sum
+Description:
+
+	Adds together all of the elements of the matrix.
+*/
+func (vm VariableMatrix) Sum() ScalarExpression {
+	return MatrixSumTemplate(vm)
+}
+
+/*
+SubMatrix
+Description:
+
+	Returns a new matrix containing the block of the variable matrix with
+	row indices in [rowStart, rowEnd) and column indices in [colStart, colEnd).
+*/
+func (vm VariableMatrix) SubMatrix(rowStart, rowEnd, colStart, colEnd int) MatrixExpression {
+	// Input Processing
+	err := vm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = checkSubMatrixRange(rowStart, rowEnd, colStart, colEnd, vm)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out VariableMatrix
+	for ii := rowStart; ii < rowEnd; ii++ {
+		var tempRow []Variable
+		for jj := colStart; jj < colEnd; jj++ {
+			tempRow = append(tempRow, vm[ii][jj])
+		}
+		out = append(out, tempRow)
+	}
+
+	return out
+}
+
+/*
+Row
+Description:
+
+	Returns row ii of the variable matrix as a vector expression.
+*/
+func (vm VariableMatrix) Row(ii int) VectorExpression {
+	return MatrixRowTemplate(vm, ii)
+}
+
+/*
+Column
+Description:
+
+	Returns column jj of the variable matrix as a vector expression.
+*/
+func (vm VariableMatrix) Column(jj int) VectorExpression {
+	return MatrixColumnTemplate(vm, jj)
+}
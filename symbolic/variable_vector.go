@@ -155,6 +155,16 @@ func (vv VariableVector) Plus(rightIn interface{}) Expression {
 
 	// Algorithm
 	switch right := rightIn.(type) {
+	case float64:
+		return vv.Plus(K(right))
+	case K, Variable, Monomial, Polynomial:
+		// Broadcast the scalar across every element of the vector
+		var out []ScalarExpression
+		for ii := 0; ii < vv.Len(); ii++ {
+			seII, _ := vv[ii].Plus(right).(ScalarExpression)
+			out = append(out, seII)
+		}
+		return ConcretizeVectorExpression(out)
 	case *mat.VecDense:
 		// Use KVector's method
 		return vv.Plus(VecDenseToKVector(*right))
@@ -325,6 +335,22 @@ func (vv VariableVector) Multiply(rightIn interface{}) Expression {
 		// Vector of polynomials must be (1x1)
 		rightAsVE, _ := ToVectorExpression(right)
 		return vv.Multiply(rightAsVE.AtVec(0))
+	case KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix:
+		// Matrix must be a row (1 x m), so the result is an outer product
+		rightAsME, _ := ToMatrixExpression(right)
+		if rightAsME.Dims()[1] == 1 {
+			return vv.Multiply(rightAsME.At(0, 0)) // Reuse scalar case
+		}
+
+		var result [][]ScalarExpression
+		for _, v := range vv {
+			var tempRow []ScalarExpression
+			for jj := 0; jj < rightAsME.Dims()[1]; jj++ {
+				tempRow = append(tempRow, v.Multiply(rightAsME.At(0, jj)).(ScalarExpression))
+			}
+			result = append(result, tempRow)
+		}
+		return ConcretizeMatrixExpression(result)
 	}
 
 	// Otherwise, panic
@@ -440,18 +466,29 @@ Transpose
 Description:
 
 	This method creates the transpose of the current vector and returns it.
+	The transpose is a 1 x n VariableMatrix (see AsRow).
 */
 func (vv VariableVector) Transpose() Expression {
+	return vv.AsRow()
+}
+
+/*
+AsRow
+Description:
+
+	Returns the variable vector as a 1 x n VariableMatrix (i.e., a row vector).
+	Unlike Transpose, this always returns a VariableMatrix so that the orientation
+	of the result is explicit.
+*/
+func (vv VariableVector) AsRow() VariableMatrix {
 	// Input Processing
 	err := vv.Check()
 	if err != nil {
 		panic(err)
 	}
 
-	// Create a matrix representing the transpose
-	var vmOut VariableMatrix
-	vmOut = append(vmOut, vv.Copy())
-	return vmOut
+	// Algorithm
+	return VariableMatrix{vv.Copy()}
 }
 
 /*
@@ -660,14 +697,7 @@ func (vv VariableVector) SubstituteAccordingTo(subMap map[Variable]Expression) E
 	}
 
 	// Algorithm
-	var out VectorExpression = vv
-	for tempVar, tempExpr := range subMap {
-		outSubbed := out.Substitute(tempVar, tempExpr.(ScalarExpression))
-		out = outSubbed.(VectorExpression)
-	}
-
-	return out
-
+	return VectorSubstituteAccordingToTemplate(vv, subMap)
 }
 
 /*
@@ -679,3 +709,97 @@ Description:
 func (vv VariableVector) Power(exponent int) Expression {
 	return VectorPowerTemplate(vv, exponent)
 }
+
+/*
+Dot
+Description:
+
+	Computes the dot product of the vector with the vector expression other,
+	i.e., the sum of their element-wise products.
+*/
+func (vv VariableVector) Dot(other VectorExpression) ScalarExpression {
+	return VectorDotTemplate(vv, other)
+}
+
+/*
+ElementWisePower
+Description:
+
+	Raises each element of the vector to the power of the input integer.
+	(Power, by contrast, follows the vector semantics of the Expression interface.)
+*/
+func (vv VariableVector) ElementWisePower(exponent int) Expression {
+	return VectorElementWisePowerTemplate(vv, exponent)
+}
+
+/*
+SubstituteVector
+Description:
+
+	Substitutes each variable in the vector in with the matching element of
+	replacement.
+*/
+func (vv VariableVector) SubstituteVector(in VariableVector, replacement VectorExpression) Expression {
+	// Input Processing
+	err := vv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	subMap, err := substitutionMapOf(in, replacement)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return simplifySubstitution(vv.SubstituteAccordingTo(subMap))
+}
+
+/*
+Outer
+Description:
+
+	Computes the outer product of the vector with other, i.e. the matrix
+	whose (ii,jj) element is vv[ii] * other[jj].
+*/
+func (vv VariableVector) Outer(other VectorExpression) MatrixExpression {
+	return VectorOuterTemplate(vv, other)
+}
+
+/*
+Sum
+Description:
+
+	Adds together all of the elements of the vector.
+*/
+func (vv VariableVector) Sum() ScalarExpression {
+	return VectorSumTemplate(vv)
+}
+
+/*
+Slice
+Description:
+
+	Returns a new vector containing the elements of the variable vector with
+	indices in the half-open range [start, end).
+*/
+func (vv VariableVector) Slice(start, end int) VectorExpression {
+	// Input Processing
+	err := vv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = checkSliceRange(start, end, vv)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out VariableVector
+	for ii := start; ii < end; ii++ {
+		out = append(out, vv[ii])
+	}
+
+	return out
+}
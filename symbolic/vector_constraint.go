@@ -35,6 +35,16 @@ func (vc VectorConstraint) Dims() []int {
 
 }
 
+/*
+Len
+Description:
+
+	The number of scalar constraints in the vector constraint.
+*/
+func (vc VectorConstraint) Len() int {
+	return vc.Dims()[0]
+}
+
 /*
 AtVec
 Description:
@@ -129,3 +139,26 @@ Description:
 func (vc VectorConstraint) IsLinear() bool {
 	return IsLinear(vc.RightHandSide) && IsLinear(vc.LeftHandSide)
 }
+
+/*
+ToScalarConstraints
+Description:
+
+	Splits the vector constraint into one ScalarConstraint per element, each
+	of which shares the sense of the vector constraint.
+*/
+func (vc VectorConstraint) ToScalarConstraints() []ScalarConstraint {
+	// Input Processing
+	err := vc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var scalarConstraints []ScalarConstraint
+	for ii := 0; ii < vc.LeftHandSide.Len(); ii++ {
+		scalarConstraints = append(scalarConstraints, vc.AtVec(ii))
+	}
+
+	return scalarConstraints
+}
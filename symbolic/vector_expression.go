@@ -368,7 +368,7 @@ func VectorSubstituteTemplate(ve VectorExpression, vIn Variable, se ScalarExpres
 	for ii := 0; ii < ve.Len(); ii++ {
 		eltII := ve.AtVec(ii)
 		postSub := eltII.Substitute(vIn, se)
-		result = append(result, postSub.(ScalarExpression))
+		result = append(result, collapseConstant(postSub.(ScalarExpression)))
 	}
 
 	return ConcretizeVectorExpression(result)
@@ -411,3 +411,422 @@ func VectorPowerTemplate(base VectorExpression, exponent int) Expression {
 
 	return result
 }
+
+/*
+checkSliceRange
+Description:
+
+	Verifies that the half-open range [start, end) is a non-empty range of
+	valid indices of the vector ve.
+*/
+func checkSliceRange(start, end int, ve VectorExpression) error {
+	if end <= start {
+		return fmt.Errorf(
+			"the slice range [%v, %v) is empty or inverted; expected start < end",
+			start, end,
+		)
+	}
+
+	err := smErrors.CheckIndexOnVector(start, ve)
+	if err != nil {
+		return err
+	}
+
+	return smErrors.CheckIndexOnVector(end-1, ve)
+}
+
+/*
+VectorSumTemplate
+Description:
+
+	Defines the template for adding together all of the elements of a
+	vector expression. The result is concretized, so a sum of constants is a K.
+*/
+func VectorSumTemplate(ve VectorExpression) ScalarExpression {
+	// Input Processing
+	err := ve.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if ve.Len() == 0 {
+		panic(smErrors.EmptyVectorError{Expression: ve})
+	}
+
+	// Algorithm
+	var sum Expression = ve.AtVec(0)
+	for ii := 1; ii < ve.Len(); ii++ {
+		sum = sum.Plus(ve.AtVec(ii))
+	}
+
+	return collapseConstant(sum.(ScalarExpression))
+}
+
+/*
+VectorSubstituteAccordingToTemplate
+Description:
+
+	Defines the template for substituting the variables in a vector expression
+	according to subMap. Each element is substituted on its own (with all of the
+	variables replaced at once), so the result does not depend on the order of
+	the map. The result is concretized.
+*/
+func VectorSubstituteAccordingToTemplate(ve VectorExpression, subMap map[Variable]Expression) VectorExpression {
+	// Input Processing
+	err := ve.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = CheckSubstitutionMap(subMap)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var elements []ScalarExpression
+	for ii := 0; ii < ve.Len(); ii++ {
+		elements = append(
+			elements,
+			ve.AtVec(ii).SubstituteAccordingTo(subMap).(ScalarExpression),
+		)
+	}
+
+	return ConcretizeVectorExpression(elements)
+}
+
+/*
+VectorOuterTemplate
+Description:
+
+	Defines the template for the outer product of two vector expressions.
+	If left has length m and right has length n, then the result is the
+	m x n matrix whose (ii,jj) element is left[ii] * right[jj].
+	The two vectors do not need to have the same length.
+*/
+func VectorOuterTemplate(left, right VectorExpression) MatrixExpression {
+	// Input Processing
+	err := left.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = right.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var result [][]ScalarExpression
+	for ii := 0; ii < left.Len(); ii++ {
+		var tempRow []ScalarExpression
+		for jj := 0; jj < right.Len(); jj++ {
+			product := left.AtVec(ii).Multiply(right.AtVec(jj))
+			tempRow = append(tempRow, product.(ScalarExpression))
+		}
+		result = append(result, tempRow)
+	}
+
+	return ConcretizeMatrixExpression(result)
+}
+
+/*
+VectorElementWisePowerTemplate
+Description:
+
+	Defines the template for raising each element of a vector expression to
+	the power of the input integer. The result is a vector of the same length;
+	an exponent of 0 gives a vector of ones.
+*/
+func VectorElementWisePowerTemplate(base VectorExpression, exponent int) VectorExpression {
+	// Input Processing
+	err := base.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if exponent < 0 {
+		panic(smErrors.NegativeExponentError{Exponent: exponent})
+	}
+
+	// Algorithm
+	var result []ScalarExpression
+	for ii := 0; ii < base.Len(); ii++ {
+		result = append(result, base.AtVec(ii).Power(exponent).(ScalarExpression))
+	}
+
+	return ConcretizeVectorExpression(result)
+}
+
+/*
+VectorDotTemplate
+Description:
+
+	Defines the template for the dot product of two vector expressions, i.e., the
+	sum of their element-wise products. The result is a simplified Polynomial,
+	or a K when both vectors are constant.
+*/
+func VectorDotTemplate(left, right VectorExpression) ScalarExpression {
+	// Input Processing
+	err := left.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = right.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if left.Len() != right.Len() {
+		panic(
+			smErrors.DimensionError{
+				Operation: "Dot",
+				Arg1:      left,
+				Arg2:      right,
+			},
+		)
+	}
+
+	// Algorithm
+	result := K(0.0).ToPolynomial()
+	for ii := 0; ii < left.Len(); ii++ {
+		leftII, _ := toPolynomial(left.AtVec(ii))
+		result = result.Plus(leftII.Multiply(right.AtVec(ii))).(Polynomial)
+	}
+
+	result = result.Simplify()
+	if result.IsConstant() {
+		return K(result.Constant())
+	}
+
+	return result
+}
+
+/*
+CrossProduct
+Description:
+
+	Computes the cross product of two vector expressions of length 3.
+	The result is a PolynomialVector whose elements are:
+		[ a1*b2 - a2*b1, a2*b0 - a0*b2, a0*b1 - a1*b0 ]
+	An error is returned if either vector does not have length 3.
+*/
+func CrossProduct(a, b VectorExpression) (VectorExpression, error) {
+	// Input Processing
+	err := a.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Len() != 3 || b.Len() != 3 {
+		return nil, fmt.Errorf(
+			"the cross product is only defined for vectors of length 3; received vectors of length %v and %v",
+			a.Len(),
+			b.Len(),
+		)
+	}
+
+	// Convert each element into a polynomial
+	var aAsP, bAsP []Polynomial
+	for ii := 0; ii < 3; ii++ {
+		aII, _ := toPolynomial(a.AtVec(ii))
+		aAsP = append(aAsP, aII)
+
+		bII, _ := toPolynomial(b.AtVec(ii))
+		bAsP = append(bAsP, bII)
+	}
+
+	// Algorithm
+	var pvOut PolynomialVector
+	for ii := 0; ii < 3; ii++ {
+		jj, kk := (ii+1)%3, (ii+2)%3
+		component := aAsP[jj].Multiply(bAsP[kk]).(Polynomial).Minus(
+			aAsP[kk].Multiply(bAsP[jj]),
+		)
+		pvOut = append(pvOut, component.(Polynomial))
+	}
+
+	return pvOut, nil
+}
+
+/*
+WeightedInnerProduct
+Description:
+
+	Computes the weighted inner product a^T W b of the two vector expressions
+	a and b using the weighting matrix W. The matrix W must have a.Len() rows
+	and b.Len() columns. The result is returned as a Polynomial.
+*/
+func WeightedInnerProduct(a VectorExpression, W MatrixExpression, b VectorExpression) ScalarExpression {
+	// Input Processing
+	err := a.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = W.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = b.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if W.Dims()[0] != a.Len() {
+		panic(
+			smErrors.DimensionError{
+				Operation: "WeightedInnerProduct",
+				Arg1:      a,
+				Arg2:      W,
+			},
+		)
+	}
+
+	if W.Dims()[1] != b.Len() {
+		panic(
+			smErrors.DimensionError{
+				Operation: "WeightedInnerProduct",
+				Arg1:      W,
+				Arg2:      b,
+			},
+		)
+	}
+
+	// Algorithm
+	result := K(0.0).ToPolynomial()
+	for ii := 0; ii < a.Len(); ii++ {
+		aII, _ := toPolynomial(a.AtVec(ii))
+		for jj := 0; jj < b.Len(); jj++ {
+			wIJ, _ := toPolynomial(W.At(ii, jj))
+			bJJ, _ := toPolynomial(b.AtVec(jj))
+			term := aII.Multiply(wIJ).(Polynomial).Multiply(bJJ)
+			result = result.Plus(term).(Polynomial)
+		}
+	}
+
+	return result.Simplify()
+}
+
+/*
+Jacobian
+Description:
+
+	Computes the Jacobian of the vector expression ve with respect to the
+	variables in wrt. The (i,j) entry of the result is the derivative of
+	ve.AtVec(i) with respect to wrt[j].
+*/
+func Jacobian(ve VectorExpression, wrt []Variable) MatrixExpression {
+	// Input Processing
+	err := ve.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if len(wrt) == 0 {
+		panic(
+			fmt.Errorf("Jacobian: There must be at least one variable to differentiate with respect to; received 0"),
+		)
+	}
+
+	for _, v := range wrt {
+		err = v.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	var result [][]ScalarExpression
+	for ii := 0; ii < ve.Len(); ii++ {
+		var tempRow []ScalarExpression
+		for _, v := range wrt {
+			tempRow = append(tempRow, ve.AtVec(ii).DerivativeWrt(v).(ScalarExpression))
+		}
+		result = append(result, tempRow)
+	}
+
+	return ConcretizeMatrixExpression(result)
+}
+
+/*
+EvalVector
+Description:
+
+	Evaluates each element of the vector expression ve according to the values
+	in assignment. An error is returned if any variable of ve is missing
+	from assignment.
+*/
+func EvalVector(ve VectorExpression, assignment map[Variable]float64) (mat.VecDense, error) {
+	// Input Processing
+	err := ve.Check()
+	if err != nil {
+		return mat.VecDense{}, err
+	}
+
+	// Algorithm
+	values := make([]float64, ve.Len())
+	for ii := 0; ii < ve.Len(); ii++ {
+		values[ii], err = ve.AtVec(ii).Eval(assignment)
+		if err != nil {
+			return mat.VecDense{}, err
+		}
+	}
+
+	return *mat.NewVecDense(ve.Len(), values), nil
+}
+
+/*
+RunningBalance
+Description:
+
+	Computes the running balance of a quantity whose value starts at initial and
+	changes by inflows[k] - outflows[k] at each step k. The k-th element of the
+	result is
+		initial + sum_{i <= k} (inflows[i] - outflows[i]).
+	An error is returned if inflows and outflows have different lengths.
+*/
+func RunningBalance(inflows, outflows VectorExpression, initial ScalarExpression) (VectorExpression, error) {
+	// Input Processing
+	err := inflows.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	err = outflows.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	err = initial.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	if inflows.Len() != outflows.Len() {
+		return nil, smErrors.DimensionError{
+			Operation: "RunningBalance",
+			Arg1:      inflows,
+			Arg2:      outflows,
+		}
+	}
+
+	// Algorithm
+	level, _ := toPolynomial(initial)
+	var levels []ScalarExpression
+	for ii := 0; ii < inflows.Len(); ii++ {
+		level = level.Plus(inflows.AtVec(ii)).(Polynomial)
+		level = level.Minus(outflows.AtVec(ii)).(Polynomial)
+		level = level.Simplify()
+		levels = append(levels, level.Copy())
+	}
+
+	return ConcretizeVectorExpression(levels), nil
+}
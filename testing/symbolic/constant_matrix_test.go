@@ -11,6 +11,7 @@ import (
 	getKMatrix "github.com/MatProGo-dev/SymbolicMath.go/get/KMatrix"
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
 	"math"
 	"reflect"
 	"strings"
@@ -737,6 +738,175 @@ func TestKMatrix_Multiply10(t *testing.T) {
 	}
 }
 
+/*
+TestKMatrix_Multiply11
+Description:
+
+	Tests that the Multiply() method properly
+	computes the multiplication of a (2x3) KMatrix with distinct entries by a
+	VariableVector of length 3. Each entry of the resulting PolynomialVector
+	should contain three monomials whose coefficients are given by the
+	corresponding row of the KMatrix.
+*/
+func TestKMatrix_Multiply11(t *testing.T) {
+	// Constants
+	km1 := getKMatrix.From([][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+	vv2 := symbolic.NewVariableVector(3)
+
+	// Test
+	product := km1.Multiply(vv2)
+	pv3, ok := product.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected product to be a symbolic.PolynomialVector; received %T",
+			product,
+		)
+	}
+
+	for rowIndex := 0; rowIndex < 2; rowIndex++ {
+		if len(pv3[rowIndex].Monomials) != 3 {
+			t.Errorf(
+				"Expected pv3[%v] to contain 3 monomials; received %v",
+				rowIndex,
+				len(pv3[rowIndex].Monomials),
+			)
+		}
+
+		for colIndex, v := range vv2 {
+			mIndex := pv3[rowIndex].VariableMonomialIndex(v)
+			if pv3[rowIndex].Monomials[mIndex].Coefficient != float64(km1[rowIndex][colIndex]) {
+				t.Errorf(
+					"Expected coefficient of %v in pv3[%v] to be %v; received %v",
+					v,
+					rowIndex,
+					km1[rowIndex][colIndex],
+					pv3[rowIndex].Monomials[mIndex].Coefficient,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestKMatrix_Multiply12
+Description:
+
+	Tests that the Multiply() method panics when a (2x3) KMatrix
+	is multiplied by a VariableVector of length 2.
+*/
+func TestKMatrix_Multiply12(t *testing.T) {
+	// Constants
+	km1 := symbolic.DenseToKMatrix(symbolic.OnesMatrix(2, 3))
+	vv2 := symbolic.NewVariableVector(2)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected Multiply to panic; received nil")
+		}
+	}()
+
+	km1.Multiply(vv2)
+}
+
+/*
+TestKMatrix_Plus8
+Description:
+
+	Tests that the Plus() method adds two (2x3) KMatrix objects element-wise
+	and returns a KMatrix.
+*/
+func TestKMatrix_Plus8(t *testing.T) {
+	// Constants
+	km1 := symbolic.KMatrix{
+		{1.0, 2.0, 3.0},
+		{4.0, 5.0, 6.0},
+	}
+	km2 := symbolic.KMatrix{
+		{0.5, -2.0, 1.0},
+		{-4.0, 0.0, 10.0},
+	}
+	expected := symbolic.KMatrix{
+		{1.5, 0.0, 4.0},
+		{0.0, 5.0, 16.0},
+	}
+
+	// Test
+	sum, ok := km1.Plus(km2).(symbolic.KMatrix)
+	if !ok {
+		t.Errorf("Expected km1.Plus(km2) to be a KMatrix; received %T", km1.Plus(km2))
+	}
+
+	if !reflect.DeepEqual(sum, expected) {
+		t.Errorf("Expected km1.Plus(km2) to be %v; received %v", expected, sum)
+	}
+}
+
+/*
+TestKMatrix_Multiply13
+Description:
+
+	Tests that the Multiply() method computes the matrix product of a (2x3)
+	KMatrix and a (3x2) KMatrix and returns a (2x2) KMatrix.
+*/
+func TestKMatrix_Multiply13(t *testing.T) {
+	// Constants
+	km1 := symbolic.KMatrix{
+		{1.0, 2.0, 3.0},
+		{4.0, 5.0, 6.0},
+	}
+	km2 := symbolic.KMatrix{
+		{1.0, 0.0},
+		{0.0, 1.0},
+		{2.0, -1.0},
+	}
+	expected := symbolic.KMatrix{
+		{7.0, -1.0},
+		{16.0, -1.0},
+	}
+
+	// Test
+	product, ok := km1.Multiply(km2).(symbolic.KMatrix)
+	if !ok {
+		t.Errorf("Expected km1.Multiply(km2) to be a KMatrix; received %T", km1.Multiply(km2))
+	}
+
+	if !reflect.DeepEqual(product, expected) {
+		t.Errorf("Expected km1.Multiply(km2) to be %v; received %v", expected, product)
+	}
+}
+
+/*
+TestKMatrix_Multiply14
+Description:
+
+	Tests that the Multiply() method panics with a DimensionError when a (2x3)
+	KMatrix is multiplied by another (2x3) KMatrix.
+*/
+func TestKMatrix_Multiply14(t *testing.T) {
+	// Constants
+	km1 := symbolic.DenseToKMatrix(symbolic.OnesMatrix(2, 3))
+	km2 := symbolic.DenseToKMatrix(symbolic.OnesMatrix(2, 3))
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected Multiply to panic; received nil")
+		}
+
+		if _, ok := r.(smErrors.DimensionError); !ok {
+			t.Errorf("Expected a DimensionError; received %T", r)
+		}
+	}()
+
+	km1.Multiply(km2)
+}
+
 /*
 TestKMatrix_Transpose1
 Description:
@@ -1342,3 +1512,470 @@ func TestKMatrix_Power2(t *testing.T) {
 		}
 	}
 }
+
+/*
+TestKMatrix_RankAndDependentRows1
+Description:
+
+	Tests that the RankAndDependentRows method correctly identifies
+	the third row of a 3x3 matrix as dependent when it is the sum
+	of the first two rows.
+*/
+func TestKMatrix_RankAndDependentRows1(t *testing.T) {
+	// Constants
+	km := symbolic.KMatrix{
+		{1, 2, 3},
+		{0, 1, 4},
+		{1, 3, 7},
+	}
+
+	// Test
+	rank, dependent := km.RankAndDependentRows(1e-9)
+	if rank != 2 {
+		t.Errorf("Expected rank to be 2; received %v", rank)
+	}
+
+	if len(dependent) != 1 {
+		t.Errorf("Expected 1 dependent row; received %v", len(dependent))
+	}
+
+	if dependent[0] != 2 {
+		t.Errorf("Expected row 2 to be dependent; received %v", dependent[0])
+	}
+}
+
+/*
+TestKMatrix_RankAndDependentRows2
+Description:
+
+	Tests that the RankAndDependentRows method reports full rank and
+	no dependent rows for the identity matrix.
+*/
+func TestKMatrix_RankAndDependentRows2(t *testing.T) {
+	// Constants
+	km := symbolic.DenseToKMatrix(symbolic.Identity(3))
+
+	// Test
+	rank, dependent := km.RankAndDependentRows(1e-9)
+	if rank != 3 {
+		t.Errorf("Expected rank to be 3; received %v", rank)
+	}
+
+	if len(dependent) != 0 {
+		t.Errorf("Expected no dependent rows; received %v", dependent)
+	}
+}
+
+/*
+TestConstantMatrix_Round1
+Description:
+
+	Verifies that Round snaps the entries of [[0.9999999, 1e-17], [3.0000001, 0.5]]
+	to [[1, 0], [3, 0.5]].
+*/
+func TestConstantMatrix_Round1(t *testing.T) {
+	// Constants
+	km := symbolic.KMatrix{
+		{0.9999999, 1e-17},
+		{3.0000001, 0.5},
+	}
+	tol := 1e-6
+
+	// Test
+	rounded := km.Round(tol)
+	expected := symbolic.KMatrix{
+		{1.0, 0.0},
+		{3.0, 0.5},
+	}
+	for ii, expectedRow := range expected {
+		for jj, elt := range expectedRow {
+			if rounded[ii][jj] != elt {
+				t.Errorf(
+					"Expected element (%v,%v) of km.Round(%v) to be %v; received %v",
+					ii, jj, tol, elt, rounded[ii][jj],
+				)
+			}
+		}
+	}
+}
+
+/*
+TestConstantMatrix_KroneckerProduct1
+Description:
+
+	Verifies that the Kronecker product of [[1, 2], [3, 4]] and [[0, 5], [6, 7]]
+	matches the hand-computed 4 x 4 result.
+*/
+func TestConstantMatrix_KroneckerProduct1(t *testing.T) {
+	// Constants
+	left := symbolic.KMatrix{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+	right := symbolic.KMatrix{
+		{0.0, 5.0},
+		{6.0, 7.0},
+	}
+
+	// Test
+	product := symbolic.KroneckerProduct(left, right)
+	expected := symbolic.KMatrix{
+		{0.0, 5.0, 0.0, 10.0},
+		{6.0, 7.0, 12.0, 14.0},
+		{0.0, 15.0, 0.0, 20.0},
+		{18.0, 21.0, 24.0, 28.0},
+	}
+
+	if product.Dims()[0] != 4 || product.Dims()[1] != 4 {
+		t.Errorf(
+			"Expected the product to have dimensions 4 x 4; received %v",
+			product.Dims(),
+		)
+	}
+
+	for ii, expectedRow := range expected {
+		for jj, elt := range expectedRow {
+			if product[ii][jj] != elt {
+				t.Errorf(
+					"Expected element (%v,%v) of the product to be %v; received %v",
+					ii, jj, elt, product[ii][jj],
+				)
+			}
+		}
+	}
+}
+
+/*
+TestConstantMatrix_KroneckerProduct2
+Description:
+
+	Verifies that the Kronecker product of a 1 x 2 and a 3 x 1 matrix
+	has dimensions 3 x 2.
+*/
+func TestConstantMatrix_KroneckerProduct2(t *testing.T) {
+	// Constants
+	left := symbolic.KMatrix{{1.0, -1.0}}
+	right := symbolic.KMatrix{{1.0}, {2.0}, {3.0}}
+
+	// Test
+	product := symbolic.KroneckerProduct(left, right)
+	if product.Dims()[0] != 3 || product.Dims()[1] != 2 {
+		t.Errorf(
+			"Expected the product to have dimensions 3 x 2; received %v",
+			product.Dims(),
+		)
+	}
+
+	if product[2][1] != -3.0 {
+		t.Errorf(
+			"Expected element (2,1) of the product to be -3; received %v",
+			product[2][1],
+		)
+	}
+}
+
+/*
+TestConstantMatrix_Sum1
+Description:
+
+	Verifies that summing the constant matrix [[1, 2], [3, 4]] gives the K 10.
+*/
+func TestConstantMatrix_Sum1(t *testing.T) {
+	// Constants
+	km := symbolic.KMatrix{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	// Test
+	sum := km.Sum()
+	sumAsK, ok := sum.(symbolic.K)
+	if !ok {
+		t.Errorf(
+			"Expected km.Sum() to return a K; received %T",
+			sum,
+		)
+	}
+
+	if float64(sumAsK) != 10.0 {
+		t.Errorf(
+			"Expected km.Sum() to be 10; received %v",
+			sumAsK,
+		)
+	}
+}
+
+/*
+TestConstantMatrix_SubMatrix1
+Description:
+
+	Verifies that SubMatrix(1, 3, 2, 4) pulls the 2 x 2 block
+	[[7, 8], [11, 12]] out of the 4 x 4 matrix with entries 1, ..., 16.
+*/
+func TestConstantMatrix_SubMatrix1(t *testing.T) {
+	// Constants
+	km := symbolic.KMatrix{
+		{1.0, 2.0, 3.0, 4.0},
+		{5.0, 6.0, 7.0, 8.0},
+		{9.0, 10.0, 11.0, 12.0},
+		{13.0, 14.0, 15.0, 16.0},
+	}
+
+	// Test
+	block := km.SubMatrix(1, 3, 2, 4)
+	blockAsKM, ok := block.(symbolic.KMatrix)
+	if !ok {
+		t.Errorf(
+			"Expected km.SubMatrix(1, 3, 2, 4) to return a KMatrix; received %T",
+			block,
+		)
+	}
+
+	expected := symbolic.KMatrix{
+		{7.0, 8.0},
+		{11.0, 12.0},
+	}
+	if blockAsKM.Dims()[0] != 2 || blockAsKM.Dims()[1] != 2 {
+		t.Errorf(
+			"Expected the block to have dimensions 2 x 2; received %v",
+			blockAsKM.Dims(),
+		)
+	}
+
+	for ii, expectedRow := range expected {
+		for jj, elt := range expectedRow {
+			if blockAsKM[ii][jj] != elt {
+				t.Errorf(
+					"Expected element (%v,%v) of the block to be %v; received %v",
+					ii, jj, elt, blockAsKM[ii][jj],
+				)
+			}
+		}
+	}
+}
+
+/*
+TestConstantMatrix_SubMatrix2
+Description:
+
+	Verifies that SubMatrix panics with an InvalidMatrixIndexError when the
+	column range extends past the edge of the matrix.
+*/
+func TestConstantMatrix_SubMatrix2(t *testing.T) {
+	// Constants
+	km := symbolic.DenseToKMatrix(symbolic.OnesMatrix(4, 4))
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected km.SubMatrix(0, 2, 3, 5) to panic; received nil",
+			)
+		}
+
+		if _, ok := r.(smErrors.InvalidMatrixIndexError); !ok {
+			t.Errorf(
+				"Expected km.SubMatrix(0, 2, 3, 5) to panic with an InvalidMatrixIndexError; received %v",
+				r,
+			)
+		}
+	}()
+
+	km.SubMatrix(0, 2, 3, 5)
+}
+
+/*
+TestConstantMatrix_Column1
+Description:
+
+	Verifies that Column(0) of a constant matrix is a KVector holding the
+	entries of the first column.
+*/
+func TestConstantMatrix_Column1(t *testing.T) {
+	// Constants
+	km := symbolic.KMatrix{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	// Test
+	column := km.Column(0)
+	columnAsKV, ok := column.(symbolic.KVector)
+	if !ok {
+		t.Errorf(
+			"Expected km.Column(0) to return a KVector; received %T",
+			column,
+		)
+	}
+
+	if columnAsKV[0] != 1.0 || columnAsKV[1] != 3.0 {
+		t.Errorf(
+			"Expected km.Column(0) to be [1, 3]; received %v",
+			columnAsKV,
+		)
+	}
+}
+
+/*
+TestConstantMatrix_IdentityK1
+Description:
+
+	Verifies that IdentityK(3) has ones on the diagonal and zeros elsewhere.
+*/
+func TestConstantMatrix_IdentityK1(t *testing.T) {
+	// Constants
+	I := symbolic.IdentityK(3)
+
+	// Test
+	if I.Dims()[0] != 3 || I.Dims()[1] != 3 {
+		t.Errorf(
+			"Expected IdentityK(3) to have dimensions 3 x 3; received %v",
+			I.Dims(),
+		)
+	}
+
+	for ii := 0; ii < 3; ii++ {
+		for jj := 0; jj < 3; jj++ {
+			expected := symbolic.K(0.0)
+			if ii == jj {
+				expected = symbolic.K(1.0)
+			}
+
+			if I[ii][jj] != expected {
+				t.Errorf(
+					"Expected element (%v,%v) of IdentityK(3) to be %v; received %v",
+					ii, jj, expected, I[ii][jj],
+				)
+			}
+		}
+	}
+}
+
+/*
+TestConstantMatrix_ZerosK1
+Description:
+
+	Verifies that ZerosK(2, 3) and OnesK(2, 3) have the right dimensions and
+	entries.
+*/
+func TestConstantMatrix_ZerosK1(t *testing.T) {
+	// Constants
+	zeros := symbolic.ZerosK(2, 3)
+	ones := symbolic.OnesK(2, 3)
+
+	// Test
+	for _, km := range []symbolic.KMatrix{zeros, ones} {
+		if km.Dims()[0] != 2 || km.Dims()[1] != 3 {
+			t.Errorf(
+				"Expected the matrix to have dimensions 2 x 3; received %v",
+				km.Dims(),
+			)
+		}
+	}
+
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 3; jj++ {
+			if zeros[ii][jj] != 0.0 || ones[ii][jj] != 1.0 {
+				t.Errorf(
+					"Expected element (%v,%v) to be 0 in ZerosK and 1 in OnesK; received %v and %v",
+					ii, jj, zeros[ii][jj], ones[ii][jj],
+				)
+			}
+		}
+	}
+}
+
+/*
+TestConstantMatrix_ZerosK2
+Description:
+
+	Verifies that ZerosK panics when given a non-positive dimension.
+*/
+func TestConstantMatrix_ZerosK2(t *testing.T) {
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected ZerosK(0, 3) to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok || !strings.Contains(rAsE.Error(), "must be positive") {
+			t.Errorf(
+				"Expected ZerosK(0, 3) to panic about non-positive dimensions; received %v",
+				r,
+			)
+		}
+	}()
+
+	symbolic.ZerosK(0, 3)
+}
+
+/*
+TestConstantMatrix_Inverse1
+Description:
+
+	Verifies that the inverse of the 2 x 2 matrix [[4, 7], [2, 6]] satisfies
+	A * A^{-1} = I (within tolerance).
+*/
+func TestConstantMatrix_Inverse1(t *testing.T) {
+	// Constants
+	A := symbolic.KMatrix{
+		{4.0, 7.0},
+		{2.0, 6.0},
+	}
+
+	// Test
+	AInv, err := A.Inverse()
+	if err != nil {
+		t.Errorf("Expected A.Inverse() to succeed; received error %v", err)
+	}
+
+	product, ok := A.Multiply(AInv).(symbolic.KMatrix)
+	if !ok {
+		t.Errorf(
+			"Expected A * A^{-1} to be a KMatrix; received %T",
+			A.Multiply(AInv),
+		)
+	}
+
+	productAsDense := product.ToDense()
+	identity := symbolic.Identity(2)
+	if !mat.EqualApprox(&productAsDense, &identity, 1e-12) {
+		t.Errorf(
+			"Expected A * A^{-1} to be the identity; received %v",
+			product,
+		)
+	}
+}
+
+/*
+TestConstantMatrix_Inverse2
+Description:
+
+	Verifies that Inverse returns an error (instead of panicking) for a
+	singular matrix and for a non-square matrix.
+*/
+func TestConstantMatrix_Inverse2(t *testing.T) {
+	// Constants
+	singular := symbolic.KMatrix{
+		{1.0, 2.0},
+		{2.0, 4.0},
+	}
+
+	// Test
+	if _, err := singular.Inverse(); err == nil {
+		t.Errorf("Expected singular.Inverse() to return an error; received nil")
+	}
+
+	_, err := symbolic.OnesK(2, 3).Inverse()
+	if err == nil || !strings.Contains(err.Error(), "not square") {
+		t.Errorf(
+			"Expected Inverse() of a 2 x 3 matrix to return a not-square error; received %v",
+			err,
+		)
+	}
+}
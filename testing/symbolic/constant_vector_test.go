@@ -12,6 +12,7 @@ import (
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
 	"gonum.org/v1/gonum/mat"
+	"math"
 	"strings"
 	"testing"
 )
@@ -1134,3 +1135,127 @@ func TestConstantVector_Multiply13(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestKVector_Dot1
+Description:
+
+	Verifies that the Dot method of two KVectors returns a K containing
+	the sum of the element-wise products.
+*/
+func TestKVector_Dot1(t *testing.T) {
+	// Constants
+	kv1 := symbolic.KVector{1.0, 2.0, 3.0}
+	kv2 := symbolic.KVector{4.0, 5.0, 6.0}
+
+	// Test
+	result := kv1.Dot(kv2)
+	resultAsK, ok := result.(symbolic.K)
+	if !ok {
+		t.Errorf("Expected Dot to return a K; received %T", result)
+	}
+
+	if float64(resultAsK) != 32.0 {
+		t.Errorf("Expected Dot to return 32; received %v", resultAsK)
+	}
+}
+
+/*
+TestConstantVector_Norm1
+Description:
+
+	Verifies that the 1-norm, 2-norm and infinity norm of the vector
+	(3, -4, 0, -1) are 8, sqrt(26) and 4 respectively.
+*/
+func TestConstantVector_Norm1(t *testing.T) {
+	// Constants
+	kv := symbolic.KVector{3.0, -4.0, 0.0, -1.0}
+	testCases := []struct {
+		p        int
+		expected float64
+	}{
+		{p: 1, expected: 8.0},
+		{p: 2, expected: math.Sqrt(26.0)},
+		{p: symbolic.InfinityNorm, expected: 4.0},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		if norm := kv.Norm(tc.p); math.Abs(norm-tc.expected) > 1e-12 {
+			t.Errorf(
+				"Expected kv.Norm(%v) to be %v; received %v",
+				tc.p, tc.expected, norm,
+			)
+		}
+	}
+}
+
+/*
+TestConstantVector_Norm2
+Description:
+
+	Verifies that Norm panics when given an unsupported value of p.
+*/
+func TestConstantVector_Norm2(t *testing.T) {
+	// Constants
+	kv := symbolic.KVector{1.0, 2.0}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected kv.Norm(3) to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok {
+			t.Errorf(
+				"Expected kv.Norm(3) to panic with an error; received %v",
+				r,
+			)
+		}
+
+		if !strings.Contains(rAsE.Error(), "unsupported norm") {
+			t.Errorf(
+				"Expected kv.Norm(3) to panic about an unsupported norm; received %v",
+				rAsE,
+			)
+		}
+	}()
+
+	kv.Norm(3)
+}
+
+/*
+TestConstantVector_Round1
+Description:
+
+	Verifies that Round snaps the vector (0.9999999, 1e-17) to (1, 0)
+	and leaves elements that are not close to an integer alone.
+*/
+func TestConstantVector_Round1(t *testing.T) {
+	// Constants
+	kv := symbolic.KVector{0.9999999, 1e-17, -1e-17, 2.5}
+	tol := 1e-6
+
+	// Test
+	rounded := kv.Round(tol)
+	expected := symbolic.KVector{1.0, 0.0, 0.0, 2.5}
+	for ii, elt := range expected {
+		if rounded[ii] != elt {
+			t.Errorf(
+				"Expected element %v of kv.Round(%v) to be %v; received %v",
+				ii, tol, elt, rounded[ii],
+			)
+		}
+	}
+
+	if strings.Contains(rounded.String(), "-0") {
+		t.Errorf(
+			"Expected kv.Round(%v) to not contain negative zeros; received %v",
+			tol, rounded,
+		)
+	}
+}
@@ -67,3 +67,76 @@ func TestConstrSense_String3(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestConstrSense_String4
+Description:
+
+	Tests that the strings of the strict senses SenseLessThan and SenseGreaterThan
+	are "<" and ">", which are distinct from the strings of the non-strict senses.
+*/
+func TestConstrSense_String4(t *testing.T) {
+	// Constants
+	testCases := []struct {
+		Strict    symbolic.ConstrSense
+		NonStrict symbolic.ConstrSense
+		Expected  string
+	}{
+		{symbolic.SenseLessThan, symbolic.SenseLessThanEqual, "<"},
+		{symbolic.SenseGreaterThan, symbolic.SenseGreaterThanEqual, ">"},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		if tc.Strict.String() != tc.Expected {
+			t.Errorf(
+				"Expected sense.String() to be \"%v\"; received %v",
+				tc.Expected,
+				tc.Strict.String(),
+			)
+		}
+
+		if tc.Strict.String() == tc.NonStrict.String() {
+			t.Errorf(
+				"Expected the strict sense %v to render differently than the non-strict sense %v",
+				tc.Strict,
+				tc.NonStrict,
+			)
+		}
+
+		if err := tc.Strict.Check(); err != nil {
+			t.Errorf("Expected %v to be a valid sense; received error %v", tc.Strict, err)
+		}
+	}
+}
+
+/*
+TestConstrSense_Comparison1
+Description:
+
+	Tests that the strict senses can be used to construct valid scalar, vector
+	and matrix constraints.
+*/
+func TestConstrSense_Comparison1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	vv := symbolic.NewVariableVector(3)
+	vm := symbolic.NewVariableMatrix(2, 2)
+
+	constraints := []symbolic.Constraint{
+		x.Comparison(1.0, symbolic.SenseLessThan),
+		vv.Comparison(symbolic.VecDenseToKVector(symbolic.OnesVector(3)), symbolic.SenseGreaterThan),
+		vm.Comparison(symbolic.DenseToKMatrix(symbolic.ZerosMatrix(2, 2)), symbolic.SenseLessThan),
+	}
+
+	// Test
+	for _, constraint := range constraints {
+		if err := constraint.Check(); err != nil {
+			t.Errorf("Expected %v to be a valid constraint; received error %v", constraint, err)
+		}
+	}
+
+	if constraints[0].ConstrSense() != symbolic.SenseLessThan {
+		t.Errorf("Expected the sense to be <; received %v", constraints[0].ConstrSense())
+	}
+}
@@ -0,0 +1,124 @@
+package symbolic_test
+
+/*
+constraint_system_test.go
+Description:
+	Tests for the functions mentioned in the constraint_system.go file.
+*/
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"strings"
+	"testing"
+)
+
+/*
+TestConstraintSystem_AddConstraint1
+Description:
+
+	Verifies that a system built from a scalar constraint and a vector constraint
+	keeps both constraints (in order) and reports the union of their variables.
+*/
+func TestConstraintSystem_AddConstraint1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariableVector(2)
+
+	scalarConstraint := x.Plus(y[0]).LessEq(1.0)
+	vectorConstraint := y.GreaterEq(symbolic.KVector{0.0, 0.0})
+
+	// Test
+	var system symbolic.ConstraintSystem
+	system.AddConstraint(scalarConstraint)
+	system.AddConstraint(vectorConstraint)
+
+	if system.Len() != 2 {
+		t.Errorf(
+			"Expected the system to contain 2 constraints; received %v",
+			system.Len(),
+		)
+	}
+
+	constraints := system.Constraints()
+	if _, ok := constraints[0].(symbolic.ScalarConstraint); !ok {
+		t.Errorf(
+			"Expected the first constraint to be a ScalarConstraint; received %T",
+			constraints[0],
+		)
+	}
+
+	if _, ok := constraints[1].(symbolic.VectorConstraint); !ok {
+		t.Errorf(
+			"Expected the second constraint to be a VectorConstraint; received %T",
+			constraints[1],
+		)
+	}
+
+	// Check the aggregated variables
+	variables := system.Variables()
+	if len(variables) != 3 {
+		t.Errorf(
+			"Expected the system to contain 3 variables; received %v",
+			len(variables),
+		)
+	}
+
+	for _, v := range []symbolic.Variable{x, y[0], y[1]} {
+		found := false
+		for _, systemVar := range variables {
+			found = found || (systemVar.ID == v.ID)
+		}
+
+		if !found {
+			t.Errorf(
+				"Expected variable %v to be in the system's variables %v",
+				v, variables,
+			)
+		}
+	}
+}
+
+/*
+TestConstraintSystem_AddConstraint2
+Description:
+
+	Verifies that AddConstraint panics when given a vector constraint whose
+	two sides have different lengths.
+*/
+func TestConstraintSystem_AddConstraint2(t *testing.T) {
+	// Constants
+	badConstraint := symbolic.VectorConstraint{
+		LeftHandSide:  symbolic.NewVariableVector(3),
+		RightHandSide: symbolic.KVector{1.0, 2.0},
+		Sense:         symbolic.SenseLessThanEqual,
+	}
+
+	var system symbolic.ConstraintSystem
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected system.AddConstraint(badConstraint) to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok || !strings.Contains(rAsE.Error(), "is not well-defined") {
+			t.Errorf(
+				"Expected system.AddConstraint(badConstraint) to panic about an ill-defined constraint; received %v",
+				r,
+			)
+		}
+
+		if system.Len() != 0 {
+			t.Errorf(
+				"Expected the system to still be empty; received %v constraints",
+				system.Len(),
+			)
+		}
+	}()
+
+	system.AddConstraint(badConstraint)
+}
@@ -0,0 +1,122 @@
+package symbolic_test
+
+/*
+environment_test.go
+Description:
+	Tests for the functions mentioned in the environment.go file.
+*/
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"testing"
+)
+
+/*
+TestEnvironment_ResetVariableCounter1
+Description:
+
+	Tests that two sequences of NewVariable calls, each made right after a call
+	to ResetVariableCounter, produce identical IDs.
+*/
+func TestEnvironment_ResetVariableCounter1(t *testing.T) {
+	symbolic.WithVariableScope(func() {
+		// Constants
+		n := 5
+
+		// Create the two sequences of variables
+		symbolic.ResetVariableCounter()
+		first := symbolic.NewVariableVector(n)
+
+		symbolic.ResetVariableCounter()
+		second := symbolic.NewVariableVector(n)
+
+		// Test
+		for ii := 0; ii < n; ii++ {
+			if first[ii].ID != second[ii].ID {
+				t.Errorf(
+					"expected variable %v of both sequences to have the same ID; received %v and %v",
+					ii, first[ii].ID, second[ii].ID,
+				)
+			}
+
+			if first[ii].ID != uint64(ii) {
+				t.Errorf(
+					"expected variable %v to have ID %v after a reset; received %v",
+					ii, ii, first[ii].ID,
+				)
+			}
+		}
+	})
+}
+
+/*
+TestEnvironment_WithVariableScope1
+Description:
+
+	Tests that the variables created inside of WithVariableScope are removed from
+	the BackgroundEnvironment afterwards, so that the next variable receives the
+	same ID that it would have received without the scope.
+*/
+func TestEnvironment_WithVariableScope1(t *testing.T) {
+	// Constants
+	nBefore := len(symbolic.BackgroundEnvironment.Variables)
+
+	// Create variables inside of the scope
+	var inside symbolic.Variable
+	symbolic.WithVariableScope(func() {
+		inside = symbolic.NewVariable()
+		symbolic.NewVariableVector(3)
+	})
+
+	// Test
+	if len(symbolic.BackgroundEnvironment.Variables) != nBefore {
+		t.Errorf(
+			"expected the BackgroundEnvironment to contain %v variables after the scope; received %v",
+			nBefore,
+			len(symbolic.BackgroundEnvironment.Variables),
+		)
+	}
+
+	after := symbolic.NewVariable()
+	if after.ID != inside.ID {
+		t.Errorf(
+			"expected the first variable after the scope to have ID %v; received %v",
+			inside.ID,
+			after.ID,
+		)
+	}
+}
+
+/*
+TestEnvironment_WithVariableScope2
+Description:
+
+	Tests that WithVariableScope restores the BackgroundEnvironment even when the
+	function it runs panics.
+*/
+func TestEnvironment_WithVariableScope2(t *testing.T) {
+	// Constants
+	nBefore := len(symbolic.BackgroundEnvironment.Variables)
+
+	// Test
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("expected the function to panic; received nil")
+			}
+		}()
+
+		symbolic.WithVariableScope(func() {
+			symbolic.NewVariableVector(4)
+			panic("test panic")
+		})
+	}()
+
+	if len(symbolic.BackgroundEnvironment.Variables) != nBefore {
+		t.Errorf(
+			"expected the BackgroundEnvironment to contain %v variables after the panic; received %v",
+			nBefore,
+			len(symbolic.BackgroundEnvironment.Variables),
+		)
+	}
+}
@@ -63,6 +63,60 @@ func TestExpression_VariableIDs1(t *testing.T) {
 	}
 }
 
+/*
+TestExpression_ContainsVariable1
+Description:
+
+	Tests that ContainsVariable returns true for a variable that appears in
+	a polynomial and false for a variable that does not.
+*/
+func TestExpression_ContainsVariable1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+	p := x.Multiply(y).Plus(x).Plus(3.0)
+
+	// Test
+	if !symbolic.ContainsVariable(p, x) {
+		t.Errorf("Expected %v to contain %v", p, x)
+	}
+
+	if !symbolic.ContainsVariable(p, y) {
+		t.Errorf("Expected %v to contain %v", p, y)
+	}
+
+	if symbolic.ContainsVariable(p, z) {
+		t.Errorf("Expected %v to not contain %v", p, z)
+	}
+}
+
+/*
+TestExpression_ContainsVariable2
+Description:
+
+	Tests that ContainsVariable searches every element of a vector expression
+	and returns false for constants.
+*/
+func TestExpression_ContainsVariable2(t *testing.T) {
+	// Constants
+	vv := symbolic.NewVariableVector(4)
+	z := symbolic.NewVariable()
+
+	// Test
+	if !symbolic.ContainsVariable(vv, vv[3]) {
+		t.Errorf("Expected %v to contain %v", vv, vv[3])
+	}
+
+	if symbolic.ContainsVariable(vv, z) {
+		t.Errorf("Expected %v to not contain %v", vv, z)
+	}
+
+	if symbolic.ContainsVariable(symbolic.K(3.0), z) {
+		t.Errorf("Expected a constant to not contain %v", z)
+	}
+}
+
 /*
 TestExpression_ToExpression1
 Description:
@@ -528,6 +582,177 @@ func TestExpression_VStack7(t *testing.T) {
 	symbolic.VStack()
 }
 
+/*
+TestExpression_VStackAll1
+Description:
+
+	Tests that VStackAll produces the same block matrix as iterated calls
+	to VStack and HStack for a grid of constant and variable blocks.
+*/
+func TestExpression_VStackAll1(t *testing.T) {
+	// Constants
+	km1 := symbolic.DenseToKMatrix(symbolic.OnesMatrix(2, 2))
+	vm1 := symbolic.NewVariableMatrix(2, 1)
+	vm2 := symbolic.NewVariableMatrix(1, 2)
+	km2 := symbolic.KMatrix{{3.0}}
+	vm3 := symbolic.NewVariableMatrix(3, 3)
+
+	parts := [][]symbolic.Expression{
+		{km1, vm1},
+		{vm2, km2},
+		{vm3},
+	}
+
+	// Test
+	result := symbolic.VStackAll(parts)
+	iterated := symbolic.VStack(
+		symbolic.VStack(
+			symbolic.HStack(km1, vm1),
+			symbolic.HStack(vm2, km2),
+		),
+		symbolic.HStack(vm3),
+	)
+
+	if result.Dims()[0] != 6 || result.Dims()[1] != 3 {
+		t.Errorf("Expected the result to be a 6x3 matrix; received %v", result.Dims())
+	}
+
+	if fmt.Sprintf("%T", result) != fmt.Sprintf("%T", iterated) {
+		t.Errorf("Expected the result to have type %T; received %T", iterated, result)
+	}
+
+	if !symbolic.MatricesEqual(
+		result.(symbolic.MatrixExpression),
+		iterated.(symbolic.MatrixExpression),
+		0.0,
+	) {
+		t.Errorf("Expected VStackAll to produce %v; received %v", iterated, result)
+	}
+}
+
+/*
+TestExpression_VStackAll2
+Description:
+
+	Tests that VStackAll panics when two rows of blocks have
+	different total numbers of columns.
+*/
+func TestExpression_VStackAll2(t *testing.T) {
+	// Constants
+	parts := [][]symbolic.Expression{
+		{symbolic.NewVariableMatrix(2, 2), symbolic.NewVariableMatrix(2, 1)},
+		{symbolic.NewVariableMatrix(1, 2)},
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected VStackAll to panic when the number of columns do not match; received nil")
+		}
+	}()
+	symbolic.VStackAll(parts)
+}
+
+/*
+TestExpression_VStackAll3
+Description:
+
+	Tests that VStackAll panics with the error from Check when one of the
+	blocks is not well-defined.
+*/
+func TestExpression_VStackAll3(t *testing.T) {
+	// Constants
+	badBlock := symbolic.MonomialMatrix{
+		{
+			symbolic.Monomial{
+				Coefficient:     1.0,
+				VariableFactors: []symbolic.Variable{symbolic.NewVariable()},
+				Exponents:       []int{1, 2},
+			},
+		},
+	}
+	parts := [][]symbolic.Expression{
+		{symbolic.NewVariableMatrix(1, 1), badBlock},
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected VStackAll to panic when a block is not well-defined; received nil")
+			return
+		}
+
+		rAsE, tf := r.(error)
+		if !tf {
+			t.Errorf("Expected the panic to be an error; received %v", r)
+			return
+		}
+
+		if rAsE.Error() != badBlock.Check().Error() {
+			t.Errorf(
+				"Expected the panic to be %v; received %v",
+				badBlock.Check(),
+				rAsE,
+			)
+		}
+	}()
+	symbolic.VStackAll(parts)
+}
+
+/*
+BenchmarkExpression_VStackAll
+Description:
+
+	Benchmarks assembling a tall (100 x 3) matrix with a single call to VStackAll.
+*/
+func BenchmarkExpression_VStackAll(b *testing.B) {
+	// Constants
+	nBlocks := 50
+	var parts [][]symbolic.Expression
+	for ii := 0; ii < nBlocks; ii++ {
+		parts = append(parts, []symbolic.Expression{
+			symbolic.NewVariableMatrix(2, 2),
+			symbolic.KMatrix{{1.0}, {2.0}},
+		})
+	}
+
+	// Benchmark
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		symbolic.VStackAll(parts)
+	}
+}
+
+/*
+BenchmarkExpression_VStackIterated
+Description:
+
+	Benchmarks assembling the same tall (100 x 3) matrix as
+	BenchmarkExpression_VStackAll with repeated calls to VStack.
+*/
+func BenchmarkExpression_VStackIterated(b *testing.B) {
+	// Constants
+	nBlocks := 50
+	var rows []symbolic.Expression
+	for ii := 0; ii < nBlocks; ii++ {
+		rows = append(rows, symbolic.HStack(
+			symbolic.NewVariableMatrix(2, 2),
+			symbolic.KMatrix{{1.0}, {2.0}},
+		))
+	}
+
+	// Benchmark
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		result := rows[0]
+		for _, row := range rows[1:] {
+			result = symbolic.VStack(result, row)
+		}
+	}
+}
+
 /*
 TestExpression_ConcretizeExpression1
 Description:
@@ -632,3 +857,125 @@ func TestExpression_ConcretizeExpression3(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestExpression_Freeze1
+Description:
+
+	Tests that mutating the monomials of a polynomial after it has been
+	frozen does not change the frozen copy, and vice versa.
+*/
+func TestExpression_Freeze1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := x.Multiply(y).(symbolic.Monomial).ToPolynomial().Plus(x).(symbolic.Polynomial)
+
+	// Test
+	frozen := symbolic.Freeze(p).(symbolic.Polynomial)
+
+	// Mutate the original
+	p.Monomials[0].Coefficient = 10.0
+	p.Monomials[0].Exponents[0] = 5
+	if frozen.Monomials[0].Coefficient != 1.0 {
+		t.Errorf(
+			"Expected frozen coefficient to remain 1; received %v",
+			frozen.Monomials[0].Coefficient,
+		)
+	}
+
+	if frozen.Monomials[0].Exponents[0] != 1 {
+		t.Errorf(
+			"Expected frozen exponent to remain 1; received %v",
+			frozen.Monomials[0].Exponents[0],
+		)
+	}
+
+	// Mutate the frozen copy
+	frozen.Monomials[1].Coefficient = -3.0
+	if p.Monomials[1].Coefficient != 1.0 {
+		t.Errorf(
+			"Expected original coefficient to remain 1; received %v",
+			p.Monomials[1].Coefficient,
+		)
+	}
+}
+
+/*
+TestExpression_Freeze2
+Description:
+
+	Tests that freezing a PolynomialVector simplifies each entry (dropping
+	cancelled terms) and that mutating the original vector does not
+	change the frozen copy.
+*/
+func TestExpression_Freeze2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		symbolic.Polynomial{
+			Monomials: []symbolic.Monomial{
+				x.ToMonomial(),
+				y.ToMonomial(),
+				x.ToMonomial().Multiply(-1.0).(symbolic.Monomial),
+			},
+		},
+		y.ToPolynomial(),
+	}
+
+	// Test
+	frozen, ok := symbolic.Freeze(pv).(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf("Expected Freeze to return a PolynomialVector; received %T", symbolic.Freeze(pv))
+	}
+
+	if len(frozen[0].Monomials) != 1 {
+		t.Errorf(
+			"Expected first entry to contain 1 monomial; received %v",
+			len(frozen[0].Monomials),
+		)
+	}
+
+	pv[1].Monomials[0].VariableFactors[0] = x
+	if frozen[1].Monomials[0].VariableFactors[0].ID != y.ID {
+		t.Errorf("Expected frozen copy to be unaffected by mutation of the original")
+	}
+}
+
+/*
+TestExpression_Freeze3
+Description:
+
+	Tests that freezing the monomial 2 y x canonicalizes it, i.e., the frozen
+	copy has its factors sorted by variable ID, and that the frozen copy does
+	not share its factors with the original.
+*/
+func TestExpression_Freeze3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	m := symbolic.Monomial{
+		Coefficient:     2.0,
+		VariableFactors: []symbolic.Variable{y, x},
+		Exponents:       []int{1, 1},
+	}
+
+	// Test
+	frozen, ok := symbolic.Freeze(m).(symbolic.Monomial)
+	if !ok {
+		t.Fatalf("Expected Freeze to return a Monomial; received %T", symbolic.Freeze(m))
+	}
+
+	if frozen.VariableFactors[0].ID != x.ID || frozen.VariableFactors[1].ID != y.ID {
+		t.Errorf(
+			"Expected frozen factors to be [%v, %v]; received %v",
+			x, y, frozen.VariableFactors,
+		)
+	}
+
+	m.Exponents[0] = 3
+	if frozen.Exponents[0] != 1 || frozen.Exponents[1] != 1 {
+		t.Errorf("Expected frozen copy to be unaffected by mutation of the original")
+	}
+}
@@ -0,0 +1,177 @@
+package symbolic_test
+
+/*
+hash_test.go
+Description:
+	Tests for the functions mentioned in the hash.go file.
+*/
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestHash_Hash1
+Description:
+
+	Verifies that two polynomials which are equal, but whose monomials (and the
+	factors of those monomials) are in different orders, have the same hash.
+*/
+func TestHash_Hash1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			{Coefficient: 2.0, VariableFactors: []symbolic.Variable{x, y}, Exponents: []int{1, 2}},
+			{Coefficient: 3.0, VariableFactors: []symbolic.Variable{x}, Exponents: []int{1}},
+			{Coefficient: 1.0, VariableFactors: []symbolic.Variable{}, Exponents: []int{}},
+		},
+	}
+	p2 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			{Coefficient: 1.0, VariableFactors: []symbolic.Variable{}, Exponents: []int{}},
+			{Coefficient: 3.0, VariableFactors: []symbolic.Variable{x}, Exponents: []int{1}},
+			{Coefficient: 2.0, VariableFactors: []symbolic.Variable{y, x}, Exponents: []int{2, 1}},
+		},
+	}
+
+	// Test
+	if !p1.Equals(p2) {
+		t.Errorf("Expected %v to equal %v; it did not", p1, p2)
+	}
+
+	if symbolic.Hash(p1) != symbolic.Hash(p2) {
+		t.Errorf(
+			"Expected %v and %v to have the same hash; received %v and %v",
+			p1, p2, symbolic.Hash(p1), symbolic.Hash(p2),
+		)
+	}
+}
+
+/*
+TestHash_Hash2
+Description:
+
+	Verifies that distinct polynomials have different hashes.
+*/
+func TestHash_Hash2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	expressions := []symbolic.Expression{
+		x.Plus(y),
+		x.Plus(y.Multiply(2.0)),
+		x.Multiply(y).Plus(x),
+		x.Multiply(y),
+		x.Power(2),
+		y.Power(2),
+		x.Plus(1.0),
+	}
+
+	// Test
+	for ii := range expressions {
+		for jj := ii + 1; jj < len(expressions); jj++ {
+			if symbolic.Hash(expressions[ii]) == symbolic.Hash(expressions[jj]) {
+				t.Errorf(
+					"Expected %v and %v to have different hashes; both were %v",
+					expressions[ii], expressions[jj], symbolic.Hash(expressions[ii]),
+				)
+			}
+		}
+	}
+}
+
+/*
+TestHash_Hash3
+Description:
+
+	Verifies that Hash distinguishes a scalar from a vector of length 1 and a
+	1x1 matrix, but not a variable from the same variable as a polynomial.
+*/
+func TestHash_Hash3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	vec := symbolic.VariableVector{x}
+	mat := symbolic.VariableMatrix{{x}}
+
+	// Test
+	if symbolic.Hash(x) != symbolic.Hash(x.ToPolynomial()) {
+		t.Errorf("Expected x and x.ToPolynomial() to have the same hash")
+	}
+
+	if symbolic.Hash(x) == symbolic.Hash(vec) {
+		t.Errorf("Expected x and [x] to have different hashes")
+	}
+
+	if symbolic.Hash(vec) == symbolic.Hash(mat) {
+		t.Errorf("Expected the vector [x] and the matrix [[x]] to have different hashes")
+	}
+}
+
+/*
+TestHash_Hash4
+Description:
+
+	Verifies that 0.1 x + 0.2 x (whose coefficient is 0.30000000000000004 after
+	simplification) and 0.3 x, which are equal according to Polynomial.Equals,
+	have the same hash.
+*/
+func TestHash_Hash4(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			x.Multiply(0.1).(symbolic.Monomial),
+			x.Multiply(0.2).(symbolic.Monomial),
+		},
+	}
+	p2 := x.Multiply(0.3).(symbolic.Monomial).ToPolynomial()
+
+	// Test
+	if !p1.Equals(p2) {
+		t.Errorf("Expected %v to equal %v; it did not", p1, p2)
+	}
+
+	if symbolic.Hash(p1) != symbolic.Hash(p2) {
+		t.Errorf(
+			"Expected %v and %v to have the same hash; received %v and %v",
+			p1, p2, symbolic.Hash(p1), symbolic.Hash(p2),
+		)
+	}
+}
+
+/*
+TestHash_Hash5
+Description:
+
+	Verifies that expressions which differ only in their coefficients
+	(e.g., x, 2 x and -x, or K(1) and K(2)) have different hashes.
+*/
+func TestHash_Hash5(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	expressions := []symbolic.Expression{
+		x,
+		x.Multiply(2.0),
+		x.Multiply(-1.0),
+		symbolic.K(1.0),
+		symbolic.K(2.0),
+		symbolic.KVector{1.0, 2.0},
+		symbolic.KVector{3.0, 4.0},
+	}
+
+	// Test
+	for ii := range expressions {
+		for jj := ii + 1; jj < len(expressions); jj++ {
+			if symbolic.Hash(expressions[ii]) == symbolic.Hash(expressions[jj]) {
+				t.Errorf(
+					"Expected %v and %v to have different hashes; both were %v",
+					expressions[ii], expressions[jj], symbolic.Hash(expressions[ii]),
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package symbolic_test
+
+/*
+latex_test.go
+Description:
+	Tests for the functions mentioned in the latex.go file.
+*/
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestLaTeX_LaTeX1
+Description:
+
+	Verifies that the polynomial x^2 + 3xy is rendered as
+		x_{id1}^{2} + 3 x_{id1} x_{id2}
+	and that negative coefficients are rendered with a leading minus.
+*/
+func TestLaTeX_LaTeX1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := x.Power(2).Plus(x.Multiply(y).Multiply(3.0)).(symbolic.Polynomial)
+
+	// Test
+	expected := fmt.Sprintf("x_{%v}^{2} + 3 x_{%v} x_{%v}", x.ID, x.ID, y.ID)
+	if symbolic.LaTeX(p) != expected {
+		t.Errorf("expected LaTeX(%v) to be %v; received %v", p, expected, symbolic.LaTeX(p))
+	}
+
+	q := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			x.ToMonomial(),
+			y.ToMonomial().Multiply(-2.0).(symbolic.Monomial),
+			symbolic.K(-1.0).ToMonomial(),
+		},
+	}
+	expected = fmt.Sprintf("x_{%v} - 2 x_{%v} - 1", x.ID, y.ID)
+	if symbolic.LaTeX(q) != expected {
+		t.Errorf("expected LaTeX(%v) to be %v; received %v", q, expected, symbolic.LaTeX(q))
+	}
+}
+
+/*
+TestLaTeX_LaTeX2
+Description:
+
+	Verifies that a 2x2 KMatrix and a VariableVector are rendered inside
+	of a bmatrix environment.
+*/
+func TestLaTeX_LaTeX2(t *testing.T) {
+	// Constants
+	km := symbolic.KMatrix{
+		{1, 2.5},
+		{-3, 4},
+	}
+	vv := symbolic.NewVariableVector(2)
+
+	// Test
+	expected := "\\begin{bmatrix} 1 & 2.5 \\\\ -3 & 4 \\end{bmatrix}"
+	if symbolic.LaTeX(km) != expected {
+		t.Errorf("expected LaTeX(%v) to be %v; received %v", km, expected, symbolic.LaTeX(km))
+	}
+
+	expected = fmt.Sprintf(
+		"\\begin{bmatrix} x_{%v} \\\\ x_{%v} \\end{bmatrix}",
+		vv[0].ID, vv[1].ID,
+	)
+	if symbolic.LaTeX(vv) != expected {
+		t.Errorf("expected LaTeX(%v) to be %v; received %v", vv, expected, symbolic.LaTeX(vv))
+	}
+}
@@ -0,0 +1,143 @@
+package symbolic_test
+
+/*
+linear_model_test.go
+Description:
+	Tests for the functions mentioned in the linear_model.go file.
+*/
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestLinearModel_ExtractLinearModel1
+Description:
+
+	Tests that ExtractLinearModel correctly assembles the LP
+		minimize   2 x - 3 y + 1
+		subject to x + y <= 4
+		           x - y >= -2
+		           [x; y] == [1; 2] (as a vector constraint)
+	over the variable ordering (x, y).
+*/
+func TestLinearModel_ExtractLinearModel1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	vars := []symbolic.Variable{x, y}
+
+	objective := x.Multiply(2.0).Plus(y.Multiply(-3.0)).Plus(1.0).(symbolic.ScalarExpression)
+	constraints := []symbolic.Constraint{
+		x.Plus(y).(symbolic.ScalarExpression).LessEq(4.0),
+		x.Minus(y).(symbolic.ScalarExpression).GreaterEq(-2.0),
+		symbolic.VariableVector{x, y}.Eq(symbolic.KVector{1.0, 2.0}),
+	}
+
+	// Test
+	c, A, senses, b, err := symbolic.ExtractLinearModel(objective, constraints, vars)
+	if err != nil {
+		t.Errorf("Expected ExtractLinearModel to succeed; received error %v", err)
+	}
+
+	// Check the objective
+	expectedC := []float64{2.0, -3.0}
+	for ii, cII := range expectedC {
+		if c.AtVec(ii) != cII {
+			t.Errorf("Expected c[%v] to be %v; received %v", ii, cII, c.AtVec(ii))
+		}
+	}
+
+	// Check the constraint rows
+	expectedA := [][]float64{
+		{1.0, 1.0},
+		{1.0, -1.0},
+		{1.0, 0.0},
+		{0.0, 1.0},
+	}
+	expectedSenses := []symbolic.ConstrSense{
+		symbolic.SenseLessThanEqual,
+		symbolic.SenseGreaterThanEqual,
+		symbolic.SenseEqual,
+		symbolic.SenseEqual,
+	}
+	expectedB := []float64{4.0, -2.0, 1.0, 2.0}
+
+	nRows, nCols := A.Dims()
+	if nRows != 4 || nCols != 2 {
+		t.Errorf("Expected A to have dimensions (4, 2); received (%v, %v)", nRows, nCols)
+	}
+
+	for ii, rowII := range expectedA {
+		for jj, aIJ := range rowII {
+			if A.At(ii, jj) != aIJ {
+				t.Errorf("Expected A[%v][%v] to be %v; received %v", ii, jj, aIJ, A.At(ii, jj))
+			}
+		}
+
+		if senses[ii] != expectedSenses[ii] {
+			t.Errorf("Expected senses[%v] to be %v; received %v", ii, expectedSenses[ii], senses[ii])
+		}
+
+		if b.AtVec(ii) != expectedB[ii] {
+			t.Errorf("Expected b[%v] to be %v; received %v", ii, expectedB[ii], b.AtVec(ii))
+		}
+	}
+}
+
+/*
+TestLinearModel_ExtractLinearModel2
+Description:
+
+	Tests that ExtractLinearModel returns an error when one of the
+	constraints is nonlinear.
+*/
+func TestLinearModel_ExtractLinearModel2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	vars := []symbolic.Variable{x, y}
+
+	constraints := []symbolic.Constraint{
+		x.Plus(y).(symbolic.ScalarExpression).LessEq(4.0),
+		x.Multiply(y).(symbolic.ScalarExpression).LessEq(1.0),
+	}
+
+	// Test
+	_, _, _, _, err := symbolic.ExtractLinearModel(x.ToPolynomial(), constraints, vars)
+	if err == nil {
+		t.Errorf("Expected ExtractLinearModel to return an error; received nil")
+	}
+}
+
+/*
+TestLinearModel_ExtractLinearModel3
+Description:
+
+	Tests that ExtractLinearModel returns an error when one of the
+	constraints uses a strict sense (< or >).
+*/
+func TestLinearModel_ExtractLinearModel3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	vars := []symbolic.Variable{x, y}
+
+	// Test
+	for _, sense := range []symbolic.ConstrSense{symbolic.SenseLessThan, symbolic.SenseGreaterThan} {
+		constraints := []symbolic.Constraint{
+			x.Plus(y).(symbolic.ScalarExpression).LessEq(4.0),
+			x.Comparison(1.0, sense),
+		}
+
+		_, _, senses, _, err := symbolic.ExtractLinearModel(x.ToPolynomial(), constraints, vars)
+		if err == nil {
+			t.Errorf(
+				"Expected ExtractLinearModel to return an error for sense %v; received nil (senses = %v)",
+				sense, senses,
+			)
+		}
+	}
+}
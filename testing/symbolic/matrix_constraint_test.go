@@ -432,3 +432,137 @@ func TestMatrixConstraint_At3(t *testing.T) {
 
 	mc.At(0, 0)
 }
+
+/*
+TestMatrixConstraint_Flatten1
+Description:
+
+	Tests that a (2x2) equality matrix constraint is flattened into a length 4
+	equality vector constraint whose elements follow the column-major order of
+	the matrix constraint.
+*/
+func TestMatrixConstraint_Flatten1(t *testing.T) {
+	// Constants
+	left := symbolic.NewVariableMatrix(2, 2)
+	right := symbolic.KMatrix{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+	mc := left.Eq(right).(symbolic.MatrixConstraint)
+
+	// Test
+	vc := mc.Flatten()
+	if vc.Len() != 4 {
+		t.Errorf("Expected vc.Len() to be 4; received %v", vc.Len())
+	}
+
+	if vc.Sense != symbolic.SenseEqual {
+		t.Errorf("Expected vc.Sense to be ==; received %v", vc.Sense)
+	}
+
+	for jj := 0; jj < 2; jj++ {
+		for ii := 0; ii < 2; ii++ {
+			sc := vc.AtVec(jj*2 + ii)
+
+			lhsAsV, ok := sc.LeftHandSide.(symbolic.Variable)
+			if !ok || lhsAsV.ID != left[ii][jj].ID {
+				t.Errorf(
+					"Expected vc.AtVec(%v).LeftHandSide to be %v; received %v",
+					jj*2+ii,
+					left[ii][jj],
+					sc.LeftHandSide,
+				)
+			}
+
+			rhsAsK, ok := sc.RightHandSide.(symbolic.K)
+			if !ok || rhsAsK != right[ii][jj] {
+				t.Errorf(
+					"Expected vc.AtVec(%v).RightHandSide to be %v; received %v",
+					jj*2+ii,
+					right[ii][jj],
+					sc.RightHandSide,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestMatrixConstraint_Flatten2
+Description:
+
+	Tests that the Flatten() method panics when the matrix constraint is not
+	well-defined (i.e., its two sides have different dimensions).
+*/
+func TestMatrixConstraint_Flatten2(t *testing.T) {
+	// Constants
+	mc := symbolic.MatrixConstraint{
+		LeftHandSide:  symbolic.NewVariableMatrix(2, 2),
+		RightHandSide: symbolic.NewVariableMatrix(3, 2),
+		Sense:         symbolic.SenseEqual,
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected mc.Flatten() to panic; received nil")
+		}
+	}()
+
+	mc.Flatten()
+}
+
+/*
+TestMatrixConstraint_StrictSenses1
+Description:
+
+	Tests that a MatrixConstraint built with SenseLessThan or SenseGreaterThan
+	is well-defined, keeps its strict sense, and passes that sense on to each
+	of its elements.
+*/
+func TestMatrixConstraint_StrictSenses1(t *testing.T) {
+	// Constants
+	nR, nC := 2, 3
+	X := symbolic.NewVariableMatrix(nR, nC)
+	right := symbolic.DenseToKMatrix(symbolic.ZerosMatrix(nR, nC))
+
+	// Test
+	for _, sense := range []symbolic.ConstrSense{symbolic.SenseLessThan, symbolic.SenseGreaterThan} {
+		mc, tf := X.Comparison(right, sense).(symbolic.MatrixConstraint)
+		if !tf {
+			t.Errorf("Expected Comparison to return a MatrixConstraint; received %T", X.Comparison(right, sense))
+			continue
+		}
+
+		if err := mc.Check(); err != nil {
+			t.Errorf("Expected constraint with sense %v to be well-defined; received %v", sense, err)
+		}
+
+		if mc.ConstrSense() != sense {
+			t.Errorf("Expected sense to be %v; received %v", sense, mc.ConstrSense())
+		}
+
+		for ii := 0; ii < nR; ii++ {
+			for jj := 0; jj < nC; jj++ {
+				sc := mc.At(ii, jj)
+				if sc.Sense != sense {
+					t.Errorf(
+						"Expected sense of constraint (%v,%v) to be %v; received %v",
+						ii, jj, sense, sc.Sense,
+					)
+				}
+
+				// Each element is violated when both sides are equal
+				satisfied, err := sc.IsSatisfiedAt(map[symbolic.Variable]float64{X[ii][jj]: 0.0})
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+
+				if satisfied {
+					t.Errorf("Expected %v to be violated when X[%v][%v] = 0; it was not", sc, ii, jj)
+				}
+			}
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"math"
 	"strings"
 	"testing"
 )
@@ -413,3 +414,791 @@ func TestMatrixExpression_MatrixSubstituteTemplate3(t *testing.T) {
 	}()
 	symbolic.MatrixSubstituteTemplate(x, v1, m1)
 }
+
+/*
+TestMatrixExpression_BlockDiagonal1
+Description:
+
+	Tests that BlockDiagonal combines a 2x2 VariableMatrix and a 1x1 KMatrix
+	into a 3x3 matrix with the blocks on the diagonal and zeros elsewhere.
+*/
+func TestMatrixExpression_BlockDiagonal1(t *testing.T) {
+	// Setup
+	vm := symbolic.NewVariableMatrix(2, 2)
+	km := symbolic.KMatrix{{5.0}}
+
+	// Test
+	bd := symbolic.BlockDiagonal(vm, km)
+	if bd.Dims()[0] != 3 || bd.Dims()[1] != 3 {
+		t.Errorf("Expected the block diagonal matrix to be 3x3; received %v", bd.Dims())
+	}
+
+	// Check the first block
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 2; jj++ {
+			vars := bd.At(ii, jj).Variables()
+			if len(vars) != 1 || vars[0].ID != vm[ii][jj].ID {
+				t.Errorf(
+					"Expected entry (%v,%v) to contain only %v; received %v",
+					ii, jj,
+					vm[ii][jj],
+					bd.At(ii, jj),
+				)
+			}
+		}
+	}
+
+	// Check the second block
+	if len(bd.At(2, 2).Variables()) != 0 || bd.At(2, 2).Constant() != 5.0 {
+		t.Errorf("Expected entry (2,2) to be 5.0; received %v", bd.At(2, 2))
+	}
+
+	// Check the off-diagonal zeros
+	zeroEntries := [][]int{{0, 2}, {1, 2}, {2, 0}, {2, 1}}
+	for _, entry := range zeroEntries {
+		elt := bd.At(entry[0], entry[1])
+		if len(elt.Variables()) != 0 || elt.Constant() != 0.0 {
+			t.Errorf(
+				"Expected entry (%v,%v) to be zero; received %v",
+				entry[0], entry[1],
+				elt,
+			)
+		}
+	}
+}
+
+/*
+TestMatrixExpression_BlockDiagonal2
+Description:
+
+	Tests that BlockDiagonal panics when no blocks are provided.
+*/
+func TestMatrixExpression_BlockDiagonal2(t *testing.T) {
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected BlockDiagonal to panic when given no blocks; received nil")
+		}
+	}()
+	symbolic.BlockDiagonal()
+}
+
+/*
+TestMatrixExpression_MatricesEqual1
+Description:
+
+	Tests that MatricesEqual returns true when comparing a MonomialMatrix
+	to a PolynomialMatrix which represents the same values using a
+	different structure (e.g., y x / 2 + x y / 2 instead of x y).
+*/
+func TestMatrixExpression_MatricesEqual1(t *testing.T) {
+	// Setup
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	xy := x.Multiply(y).(symbolic.Monomial)
+	halfYX := y.Multiply(x).Multiply(0.5).(symbolic.Monomial)
+	halfXY := xy.Multiply(0.5).(symbolic.Monomial)
+
+	mm := symbolic.MonomialMatrix{
+		{xy, x.ToMonomial()},
+		{symbolic.K(2.0).ToMonomial(), xy},
+	}
+	pm := symbolic.PolynomialMatrix{
+		{
+			symbolic.Polynomial{Monomials: []symbolic.Monomial{halfYX, halfXY}},
+			x.ToPolynomial(),
+		},
+		{
+			symbolic.K(2.0).ToPolynomial(),
+			symbolic.Polynomial{Monomials: []symbolic.Monomial{halfXY, halfYX}},
+		},
+	}
+
+	// Test
+	if !symbolic.MatricesEqual(mm, pm, 1e-9) {
+		t.Errorf("Expected %v and %v to be equal", mm, pm)
+	}
+
+	if !symbolic.MatricesEqual(mm, mm.Transpose().Transpose().(symbolic.MatrixExpression), 1e-9) {
+		t.Errorf("Expected %v to equal its double transpose", mm)
+	}
+}
+
+/*
+TestMatrixExpression_MatricesEqual2
+Description:
+
+	Tests that MatricesEqual returns false (rather than panicking) when
+	the two matrices have different dimensions, and false when an entry differs.
+*/
+func TestMatrixExpression_MatricesEqual2(t *testing.T) {
+	// Setup
+	vm := symbolic.NewVariableMatrix(2, 3)
+	km1 := symbolic.KMatrix{{1.0, 2.0}, {3.0, 4.0}}
+	km2 := symbolic.KMatrix{{1.0, 2.0}, {3.0, 4.5}}
+
+	// Test
+	if symbolic.MatricesEqual(vm, km1, 1e-9) {
+		t.Errorf("Expected matrices with different dimensions to not be equal")
+	}
+
+	if symbolic.MatricesEqual(km1, km2, 1e-9) {
+		t.Errorf("Expected %v and %v to not be equal", km1, km2)
+	}
+}
+
+/*
+TestMatrixExpression_VandermondeMatrix1
+Description:
+
+	Tests that the Vandermonde matrix of a length-3 variable vector with degree 2
+	is a 3x3 matrix whose first column is all ones and whose (i,j) entry
+	is v[i]^j.
+*/
+func TestMatrixExpression_VandermondeMatrix1(t *testing.T) {
+	// Setup
+	v := symbolic.NewVariableVector(3)
+
+	// Test
+	vdm := symbolic.VandermondeMatrix(v, 2)
+	if vdm.Dims()[0] != 3 || vdm.Dims()[1] != 3 {
+		t.Errorf("Expected the Vandermonde matrix to be 3x3; received %v", vdm.Dims())
+	}
+
+	for ii := 0; ii < 3; ii++ {
+		// Check the first column
+		first := vdm.At(ii, 0)
+		if len(first.Variables()) != 0 || first.Constant() != 1.0 {
+			t.Errorf("Expected entry (%v,0) to be 1; received %v", ii, first)
+		}
+
+		// Check the later columns
+		for jj := 1; jj < 3; jj++ {
+			elt := vdm.At(ii, jj)
+			eltAsM, tf := elt.(symbolic.Monomial)
+			if !tf {
+				t.Errorf("Expected entry (%v,%v) to be a Monomial; received %T", ii, jj, elt)
+				continue
+			}
+
+			if len(eltAsM.VariableFactors) != 1 || eltAsM.VariableFactors[0].ID != v[ii].ID {
+				t.Errorf("Expected entry (%v,%v) to only contain %v; received %v", ii, jj, v[ii], elt)
+			}
+
+			if eltAsM.Degree() != jj || eltAsM.Coefficient != 1.0 {
+				t.Errorf("Expected entry (%v,%v) to be %v^%v; received %v", ii, jj, v[ii], jj, elt)
+			}
+		}
+	}
+}
+
+/*
+TestMatrixExpression_VandermondeMatrix2
+Description:
+
+	Tests that VandermondeMatrix panics with a NegativeExponentError
+	when given a negative degree.
+*/
+func TestMatrixExpression_VandermondeMatrix2(t *testing.T) {
+	// Setup
+	v := symbolic.NewVariableVector(3)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected VandermondeMatrix to panic with a negative degree; received nil")
+		}
+
+		rAsE, tf := r.(error)
+		if !tf {
+			t.Errorf("Expected the panic to be an error; received %T", r)
+		}
+
+		expectedError := smErrors.NegativeExponentError{Exponent: -1}
+		if rAsE.Error() != expectedError.Error() {
+			t.Errorf("Expected the panic to be %v; received %v", expectedError, rAsE)
+		}
+	}()
+	symbolic.VandermondeMatrix(v, -1)
+}
+
+/*
+TestMatrixExpression_FrobeniusInnerProduct1
+Description:
+
+	Tests that the FrobeniusInnerProduct of a 2x2 VariableMatrix X and a 2x2
+	KMatrix C is the polynomial sum_{i,j} C[i][j] * X[i][j].
+*/
+func TestMatrixExpression_FrobeniusInnerProduct1(t *testing.T) {
+	// Constants
+	X := symbolic.NewVariableMatrix(2, 2)
+	C := symbolic.KMatrix{
+		{1, 2},
+		{3, 4},
+	}
+
+	// Test
+	result := symbolic.FrobeniusInnerProduct(X, C)
+
+	expected := symbolic.K(0.0).ToPolynomial()
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 2; jj++ {
+			expected = expected.Plus(X[ii][jj].Multiply(C[ii][jj])).(symbolic.Polynomial)
+		}
+	}
+
+	if !symbolic.AreEqual(result, expected, 1e-10) {
+		t.Errorf("Expected FrobeniusInnerProduct to be %v; received %v", expected, result)
+	}
+
+	// Check each coefficient
+	resultAsP, ok := result.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf("Expected result to be a Polynomial; received %T", result)
+	}
+
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 2; jj++ {
+			coeff := resultAsP.Monomials[resultAsP.VariableMonomialIndex(X[ii][jj])].Coefficient
+			if coeff != float64(C[ii][jj]) {
+				t.Errorf(
+					"Expected coefficient of X[%v][%v] to be %v; received %v",
+					ii, jj, C[ii][jj], coeff,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestMatrixExpression_FrobeniusInnerProduct2
+Description:
+
+	Tests that the FrobeniusInnerProduct of two KMatrix objects is a K
+	containing the sum of the element-wise products and that mismatched
+	shapes cause a panic.
+*/
+func TestMatrixExpression_FrobeniusInnerProduct2(t *testing.T) {
+	// Constants
+	A := symbolic.KMatrix{
+		{1, 2},
+		{3, 4},
+	}
+	B := symbolic.KMatrix{
+		{5, 6},
+		{7, 8},
+	}
+
+	// Test
+	result := symbolic.FrobeniusInnerProduct(A, B)
+	resultAsK, ok := result.(symbolic.K)
+	if !ok {
+		t.Errorf("Expected result to be a K; received %T", result)
+	}
+
+	if float64(resultAsK) != 70.0 {
+		t.Errorf("Expected FrobeniusInnerProduct to be 70; received %v", resultAsK)
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected FrobeniusInnerProduct to panic; received nil")
+		}
+	}()
+
+	symbolic.FrobeniusInnerProduct(A, symbolic.KMatrix{{1, 2, 3}})
+}
+
+/*
+TestMatrixExpression_EvalMatrix1
+Description:
+
+	Tests that EvalMatrix evaluates each element of a VariableMatrix and
+	returns an error when one of the variables is missing from the
+	assignment.
+*/
+func TestMatrixExpression_EvalMatrix1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(2, 2)
+	assignment := map[symbolic.Variable]float64{
+		vm[0][0]: 1.0, vm[0][1]: 2.0,
+		vm[1][0]: 3.0, vm[1][1]: 4.0,
+	}
+
+	// Test
+	values, err := symbolic.EvalMatrix(vm, assignment)
+	if err != nil {
+		t.Errorf("Expected EvalMatrix to succeed; received error %v", err)
+	}
+
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 2; jj++ {
+			if values.At(ii, jj) != assignment[vm[ii][jj]] {
+				t.Errorf(
+					"Expected element (%v,%v) to be %v; received %v",
+					ii, jj, assignment[vm[ii][jj]], values.At(ii, jj),
+				)
+			}
+		}
+	}
+
+	delete(assignment, vm[1][1])
+	_, err = symbolic.EvalMatrix(vm, assignment)
+	if err == nil {
+		t.Errorf("Expected EvalMatrix to return an error; received nil")
+	}
+}
+
+/*
+TestMatrixExpression_GramMatrix1
+Description:
+
+	Tests that the GramMatrix of a VariableVector of length 3 is a 3x3
+	MonomialMatrix whose (i,j) and (j,i) entries match and whose diagonal
+	contains the squares of the variables.
+*/
+func TestMatrixExpression_GramMatrix1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(3)
+
+	// Test
+	G := symbolic.GramMatrix(x)
+	GAsMM, ok := G.(symbolic.MonomialMatrix)
+	if !ok {
+		t.Errorf("Expected GramMatrix to return a MonomialMatrix; received %T", G)
+	}
+
+	if G.Dims()[0] != 3 || G.Dims()[1] != 3 {
+		t.Errorf("Expected GramMatrix to have dimensions [3,3]; received %v", G.Dims())
+	}
+
+	for ii := 0; ii < 3; ii++ {
+		for jj := 0; jj < 3; jj++ {
+			if !symbolic.AreEqual(GAsMM[ii][jj], GAsMM[jj][ii], 0.0) {
+				t.Errorf(
+					"Expected G[%v][%v] = %v to match G[%v][%v] = %v",
+					ii, jj, GAsMM[ii][jj], jj, ii, GAsMM[jj][ii],
+				)
+			}
+
+			expected := x[ii].Multiply(x[jj]).(symbolic.ScalarExpression)
+			if !symbolic.AreEqual(GAsMM[ii][jj], expected, 0.0) {
+				t.Errorf("Expected G[%v][%v] to be %v; received %v", ii, jj, expected, GAsMM[ii][jj])
+			}
+		}
+
+		if GAsMM[ii][ii].Degree() != 2 || len(GAsMM[ii][ii].VariableFactors) != 1 {
+			t.Errorf("Expected G[%v][%v] to be the square of %v; received %v", ii, ii, x[ii], GAsMM[ii][ii])
+		}
+	}
+}
+
+/*
+TestMatrixExpression_GramMatrix2
+Description:
+
+	Tests that GramMatrix panics when given an empty vector.
+*/
+func TestMatrixExpression_GramMatrix2(t *testing.T) {
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected GramMatrix to panic; received nil")
+		}
+	}()
+
+	symbolic.GramMatrix(symbolic.VariableVector{})
+}
+
+/*
+TestMatrixExpression_GramMatrix3
+Description:
+
+	Tests that the (i,j) and (j,i) entries of a GramMatrix do not share memory,
+	i.e., modifying the factors of G[0][1] leaves G[1][0] unchanged.
+*/
+func TestMatrixExpression_GramMatrix3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(2)
+
+	// Test
+	GAsMM := symbolic.GramMatrix(x).(symbolic.MonomialMatrix)
+	GAsMM[0][1].Exponents[0] = 3
+
+	if !symbolic.AreEqual(GAsMM[1][0], x[0].Multiply(x[1]).(symbolic.ScalarExpression), 0.0) {
+		t.Errorf(
+			"Expected G[1][0] to remain %v after modifying G[0][1]; received %v",
+			x[0].Multiply(x[1]),
+			GAsMM[1][0],
+		)
+	}
+}
+
+/*
+TestMatrixExpression_Trace1
+Description:
+
+	Tests that the Trace of an n x n VariableMatrix is a polynomial with
+	n monomials (one for each diagonal variable) and that the Trace of a
+	KMatrix is the correct constant.
+*/
+func TestMatrixExpression_Trace1(t *testing.T) {
+	// Constants
+	n := 4
+	vm := symbolic.NewVariableMatrix(n, n)
+	km := symbolic.KMatrix{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+
+	// Test
+	trVM := symbolic.Trace(vm)
+	trVMAsP, ok := trVM.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf("Expected Trace to return a Polynomial; received %T", trVM)
+	}
+
+	if len(trVMAsP.Monomials) != n {
+		t.Errorf("Expected Trace to contain %v monomials; received %v", n, trVMAsP)
+	}
+
+	for ii := 0; ii < n; ii++ {
+		if trVMAsP.VariableMonomialIndex(vm[ii][ii]) == -1 {
+			t.Errorf("Expected Trace to contain %v; received %v", vm[ii][ii], trVMAsP)
+		}
+	}
+
+	trKM := symbolic.Trace(km)
+	trKMAsK, ok := trKM.(symbolic.K)
+	if !ok {
+		t.Errorf("Expected Trace to return a K; received %T", trKM)
+	}
+
+	if float64(trKMAsK) != 15.0 {
+		t.Errorf("Expected Trace to be 15; received %v", trKMAsK)
+	}
+}
+
+/*
+TestMatrixExpression_Trace2
+Description:
+
+	Tests that Trace panics when given a non-square matrix.
+*/
+func TestMatrixExpression_Trace2(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(2, 3)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected Trace to panic; received nil")
+		}
+
+		rAsE, ok := r.(error)
+		if !ok || !strings.Contains(rAsE.Error(), "not square") {
+			t.Errorf("Expected Trace to panic with a non-square error; received %v", r)
+		}
+	}()
+
+	symbolic.Trace(vm)
+}
+
+/*
+TestMatrixExpression_Diag1
+Description:
+
+	Verifies that Diag of the KVector (1, 2, 3) is the 3 x 3 KMatrix with
+	1, 2, 3 on its diagonal and zeros elsewhere.
+*/
+func TestMatrixExpression_Diag1(t *testing.T) {
+	// Constants
+	kv := symbolic.KVector{1.0, 2.0, 3.0}
+
+	// Test
+	d := symbolic.Diag(kv)
+	dAsKM, ok := d.(symbolic.KMatrix)
+	if !ok {
+		t.Errorf(
+			"Expected Diag(kv) to return a KMatrix; received %T",
+			d,
+		)
+	}
+
+	for ii := 0; ii < 3; ii++ {
+		for jj := 0; jj < 3; jj++ {
+			expected := symbolic.K(0.0)
+			if ii == jj {
+				expected = kv[ii]
+			}
+
+			if dAsKM[ii][jj] != expected {
+				t.Errorf(
+					"Expected element (%v,%v) of Diag(kv) to be %v; received %v",
+					ii, jj, expected, dAsKM[ii][jj],
+				)
+			}
+		}
+	}
+}
+
+/*
+TestMatrixExpression_Diag2
+Description:
+
+	Verifies that Diag of a VariableVector is a MonomialMatrix and that
+	Diagonal(Diag(v)) recovers v.
+*/
+func TestMatrixExpression_Diag2(t *testing.T) {
+	// Constants
+	vv := symbolic.NewVariableVector(3)
+
+	// Test
+	d := symbolic.Diag(vv)
+	if _, ok := d.(symbolic.MonomialMatrix); !ok {
+		t.Errorf(
+			"Expected Diag(vv) to return a MonomialMatrix; received %T",
+			d,
+		)
+	}
+
+	recovered := symbolic.Diagonal(d)
+	if recovered.Len() != vv.Len() {
+		t.Errorf(
+			"Expected Diagonal(Diag(vv)) to have length %v; received %v",
+			vv.Len(), recovered.Len(),
+		)
+	}
+
+	for ii := 0; ii < vv.Len(); ii++ {
+		if !symbolic.AreEqual(recovered.AtVec(ii), vv[ii], 1e-12) {
+			t.Errorf(
+				"Expected element %v of Diagonal(Diag(vv)) to be %v; received %v",
+				ii, vv[ii], recovered.AtVec(ii),
+			)
+		}
+	}
+}
+
+/*
+TestMatrixExpression_Diagonal1
+Description:
+
+	Verifies that Diagonal extracts (1, 4) from the KMatrix [[1, 2], [3, 4]]
+	and panics for a non-square matrix.
+*/
+func TestMatrixExpression_Diagonal1(t *testing.T) {
+	// Constants
+	km := symbolic.KMatrix{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	// Test
+	diagonal := symbolic.Diagonal(km)
+	diagonalAsKV, ok := diagonal.(symbolic.KVector)
+	if !ok {
+		t.Errorf(
+			"Expected Diagonal(km) to return a KVector; received %T",
+			diagonal,
+		)
+	}
+
+	if diagonalAsKV[0] != 1.0 || diagonalAsKV[1] != 4.0 {
+		t.Errorf(
+			"Expected Diagonal(km) to be [1, 4]; received %v",
+			diagonalAsKV,
+		)
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected Diagonal to panic for a 2 x 3 matrix; received nil",
+			)
+		}
+	}()
+
+	symbolic.Diagonal(symbolic.DenseToKMatrix(symbolic.OnesMatrix(2, 3)))
+}
+
+/*
+TestMatrixExpression_Determinant1
+Description:
+
+	Verifies that the determinant of the 2 x 2 VariableMatrix [[a, b], [c, d]]
+	is the polynomial a d - b c.
+*/
+func TestMatrixExpression_Determinant1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(2, 2)
+	a, b, c, d := vm[0][0], vm[0][1], vm[1][0], vm[1][1]
+
+	// Test
+	det := symbolic.Determinant(vm)
+	if _, ok := det.(symbolic.Polynomial); !ok {
+		t.Errorf(
+			"Expected Determinant(vm) to be a Polynomial; received %T",
+			det,
+		)
+	}
+
+	expected := a.Multiply(d).Minus(b.Multiply(c)).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(det, expected, 1e-12) {
+		t.Errorf(
+			"Expected Determinant(vm) to be %v; received %v",
+			expected, det,
+		)
+	}
+}
+
+/*
+TestMatrixExpression_Determinant2
+Description:
+
+	Verifies that the determinant of the constant matrix
+	[[2, 0, 1], [1, 3, 2], [1, 1, 1]] is the K 0 and that the
+	determinant of [[6, 1, 1], [4, -2, 5], [2, 8, 7]] is the K -306.
+*/
+func TestMatrixExpression_Determinant2(t *testing.T) {
+	// Constants
+	testCases := []struct {
+		km       symbolic.KMatrix
+		expected float64
+	}{
+		{
+			km:       symbolic.KMatrix{{2.0, 0.0, 1.0}, {1.0, 3.0, 2.0}, {1.0, 1.0, 1.0}},
+			expected: 0.0,
+		},
+		{
+			km:       symbolic.KMatrix{{6.0, 1.0, 1.0}, {4.0, -2.0, 5.0}, {2.0, 8.0, 7.0}},
+			expected: -306.0,
+		},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		det := symbolic.Determinant(tc.km)
+		detAsK, ok := det.(symbolic.K)
+		if !ok {
+			t.Errorf(
+				"Expected Determinant(km) to be a K; received %T",
+				det,
+			)
+			continue
+		}
+
+		if math.Abs(float64(detAsK)-tc.expected) > 1e-12 {
+			t.Errorf(
+				"Expected Determinant(%v) to be %v; received %v",
+				tc.km, tc.expected, detAsK,
+			)
+		}
+	}
+}
+
+/*
+TestMatrixExpression_Determinant3
+Description:
+
+	Verifies that Determinant panics for non-square matrices and for
+	matrices larger than MaxDeterminantDim.
+*/
+func TestMatrixExpression_Determinant3(t *testing.T) {
+	// Constants
+	inputs := []symbolic.MatrixExpression{
+		symbolic.OnesK(2, 3),
+		symbolic.IdentityK(symbolic.MaxDeterminantDim + 1),
+	}
+
+	// Test
+	for _, input := range inputs {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf(
+						"Expected Determinant to panic for a matrix of dimensions %v; received nil",
+						input.Dims(),
+					)
+				}
+			}()
+
+			symbolic.Determinant(input)
+		}()
+	}
+}
+
+/*
+TestMatrixExpression_ToPolynomialMatrix1
+Description:
+
+	Verifies that each of the matrix expression types can be converted to a
+	PolynomialMatrix of the same shape with the expected number of monomials
+	in each entry.
+*/
+func TestMatrixExpression_ToPolynomialMatrix1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	vm := symbolic.NewVariableMatrix(2, 2)
+	testCases := []struct {
+		name      string
+		converted symbolic.PolynomialMatrix
+		nMonos    [][]int
+	}{
+		{
+			name:      "KMatrix",
+			converted: symbolic.OnesK(2, 2).ToPolynomialMatrix(),
+			nMonos:    [][]int{{1, 1}, {1, 1}},
+		},
+		{
+			name:      "VariableMatrix",
+			converted: vm.ToPolynomialMatrix(),
+			nMonos:    [][]int{{1, 1}, {1, 1}},
+		},
+		{
+			name: "MonomialMatrix",
+			converted: symbolic.MonomialMatrix{
+				{x.ToMonomial(), x.Power(2).(symbolic.Monomial)},
+				{symbolic.K(3.0).ToMonomial(), vm[0][0].Multiply(vm[1][1]).(symbolic.Monomial)},
+			}.ToPolynomialMatrix(),
+			nMonos: [][]int{{1, 1}, {1, 1}},
+		},
+		{
+			name: "PolynomialMatrix",
+			converted: symbolic.PolynomialMatrix{
+				{x.Plus(1.0).(symbolic.Polynomial), x.ToPolynomial()},
+				{symbolic.K(3.0).ToPolynomial(), x.Plus(vm[0][0]).Plus(2.0).(symbolic.Polynomial)},
+			}.ToPolynomialMatrix(),
+			nMonos: [][]int{{2, 1}, {1, 3}},
+		},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		if tc.converted.Dims()[0] != 2 || tc.converted.Dims()[1] != 2 {
+			t.Errorf(
+				"Expected the converted %v to have dimensions 2 x 2; received %v",
+				tc.name, tc.converted.Dims(),
+			)
+			continue
+		}
+
+		for ii, row := range tc.nMonos {
+			for jj, nMonos := range row {
+				if len(tc.converted[ii][jj].Monomials) != nMonos {
+					t.Errorf(
+						"Expected entry (%v,%v) of the converted %v to have %v monomials; received %v",
+						ii, jj, tc.name, nMonos, len(tc.converted[ii][jj].Monomials),
+					)
+				}
+			}
+		}
+	}
+}
@@ -2267,3 +2267,35 @@ func TestMonomialMatrix_SubstituteAccordingTo3(t *testing.T) {
 	mm.SubstituteAccordingTo(testMap)
 	t.Errorf("expected SubstituteAccordingTo() to panic; it did not")
 }
+
+/*
+TestMonomialMatrix_IsConstant1
+Description:
+
+	Verifies that IsConstant returns true for a matrix of constant monomials
+	and false once one of the entries contains a variable.
+*/
+func TestMonomialMatrix_IsConstant1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	mm := symbolic.MonomialMatrix{
+		{symbolic.K(1.0).ToMonomial(), symbolic.K(2.0).ToMonomial()},
+		{symbolic.K(3.0).ToMonomial(), symbolic.K(4.0).ToMonomial()},
+	}
+
+	// Test
+	if !mm.IsConstant() {
+		t.Errorf(
+			"Expected mm.IsConstant() to be true for %v; received false",
+			mm,
+		)
+	}
+
+	mm[1][0] = x.ToMonomial()
+	if mm.IsConstant() {
+		t.Errorf(
+			"Expected mm.IsConstant() to be false for %v; received true",
+			mm,
+		)
+	}
+}
@@ -8,6 +8,7 @@ Description:
 
 import (
 	"fmt"
+	"math"
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
 	"strings"
@@ -96,6 +97,70 @@ func TestMonomial_Check3(t *testing.T) {
 	}
 }
 
+/*
+TestMonomial_Check4
+Description:
+
+	Verifies that the Check() method returns an error when the monomial
+	contains a negative exponent.
+*/
+func TestMonomial_Check4(t *testing.T) {
+	// Constants
+	v1 := symbolic.NewVariable()
+	v2 := symbolic.NewVariable()
+
+	m1 := symbolic.Monomial{
+		Coefficient:     2.0,
+		VariableFactors: []symbolic.Variable{v1, v2},
+		Exponents:       []int{1, -2},
+	}
+
+	// Test
+	err := m1.Check()
+	if err == nil {
+		t.Errorf(
+			"expected Check() to return an error for a negative exponent; received nil",
+		)
+	} else if !strings.Contains(err.Error(), "negative") {
+		t.Errorf(
+			"expected Check() error to mention the negative exponent; received %v",
+			err,
+		)
+	}
+}
+
+/*
+TestMonomial_Check5
+Description:
+
+	Verifies that the Check() method returns an error when the same variable
+	appears more than once in the variable factors of the monomial.
+*/
+func TestMonomial_Check5(t *testing.T) {
+	// Constants
+	v1 := symbolic.NewVariable()
+	v2 := symbolic.NewVariable()
+
+	m1 := symbolic.Monomial{
+		Coefficient:     2.0,
+		VariableFactors: []symbolic.Variable{v1, v2, v1},
+		Exponents:       []int{1, 2, 3},
+	}
+
+	// Test
+	err := m1.Check()
+	if err == nil {
+		t.Errorf(
+			"expected Check() to return an error for a repeated variable; received nil",
+		)
+	} else if !strings.Contains(err.Error(), "more than once") {
+		t.Errorf(
+			"expected Check() error to mention the repeated variable; received %v",
+			err,
+		)
+	}
+}
+
 /*
 TestMonomial_Plus1
 Description:
@@ -178,8 +243,8 @@ func TestMonomial_Plus2(t *testing.T) {
 TestMonomial_Plus3
 Description:
 
-	Tests that the addition of a monomial with a variable is a monomial,
-	when the monomial contains just the variable.
+	Tests that the addition of a monomial with a variable is a polynomial
+	containing a single monomial, when the monomial contains just the variable.
 */
 func TestMonomial_Plus3(t *testing.T) {
 	// Constants
@@ -193,15 +258,23 @@ func TestMonomial_Plus3(t *testing.T) {
 	// Compute Sum
 	sum := m2.Plus(v1)
 
-	// Verify that the sum is a monomial
-	sumAsM, tf := sum.(symbolic.Monomial)
+	// Verify that the sum is a polynomial with a single monomial
+	sumAsP, tf := sum.(symbolic.Polynomial)
 	if !tf {
 		t.Errorf(
-			"expected sum to be a monomial; received %T",
+			"expected sum to be a polynomial; received %T",
 			sum,
 		)
 	}
 
+	if len(sumAsP.Monomials) != 1 {
+		t.Fatalf(
+			"expected sum to have 1 monomial; received %v",
+			len(sumAsP.Monomials),
+		)
+	}
+	sumAsM := sumAsP.Monomials[0]
+
 	// Check that the monomial is well formed with Check
 	if sumAsM.Check() != nil {
 		t.Errorf(
@@ -217,6 +290,13 @@ func TestMonomial_Plus3(t *testing.T) {
 			sumAsM.VariableFactors[0],
 		)
 	}
+
+	if math.Abs(sumAsM.Coefficient-4.14) > 1e-10 {
+		t.Errorf(
+			"expected sum to have coefficient 4.14; received %v",
+			sumAsM.Coefficient,
+		)
+	}
 }
 
 /*
@@ -403,6 +483,129 @@ func TestMonomial_Plus8(t *testing.T) {
 	}
 }
 
+/*
+TestMonomial_Plus9
+Description:
+
+	Verifies that the sum 2x + 3x of two monomials with the same variables
+	and exponents is a polynomial with a single monomial with coefficient 5.
+*/
+func TestMonomial_Plus9(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	m1 := x.Multiply(2.0).(symbolic.Monomial)
+	m2 := x.Multiply(3.0).(symbolic.Monomial)
+
+	// Compute Sum
+	sum := m1.Plus(m2)
+
+	// Verify that the sum is a polynomial with one monomial
+	sumAsP, tf := sum.(symbolic.Polynomial)
+	if !tf {
+		t.Errorf(
+			"expected sum to be a polynomial; received %T",
+			sum,
+		)
+	}
+
+	if len(sumAsP.Monomials) != 1 {
+		t.Errorf(
+			"expected sum to have 1 monomial; received %v",
+			len(sumAsP.Monomials),
+		)
+	}
+
+	if sumAsP.Monomials[0].Coefficient != 5.0 {
+		t.Errorf(
+			"expected the coefficient of the sum to be 5; received %v",
+			sumAsP.Monomials[0].Coefficient,
+		)
+	}
+
+	if !sumAsP.Monomials[0].MatchesFormOf(x.ToMonomial()) {
+		t.Errorf(
+			"expected the monomial of the sum to be of the form x; received %v",
+			sumAsP.Monomials[0],
+		)
+	}
+}
+
+/*
+TestMonomial_Plus10
+Description:
+
+	Verifies that the sum of two monomials with different variables is a
+	polynomial with both monomials.
+*/
+func TestMonomial_Plus10(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	m1 := x.Multiply(2.0).(symbolic.Monomial)
+	m2 := y.Multiply(3.0).(symbolic.Monomial)
+
+	// Compute Sum
+	sum := m1.Plus(m2)
+
+	// Verify that the sum is a polynomial with two monomials
+	sumAsP, tf := sum.(symbolic.Polynomial)
+	if !tf {
+		t.Errorf(
+			"expected sum to be a polynomial; received %T",
+			sum,
+		)
+	}
+
+	if len(sumAsP.Monomials) != 2 {
+		t.Errorf(
+			"expected sum to have 2 monomials; received %v",
+			len(sumAsP.Monomials),
+		)
+	}
+}
+
+/*
+TestMonomial_Plus11
+Description:
+
+	Verifies that adding x to the monomial x returns the same type whether x is
+	given as a Variable or as a Monomial (a Polynomial with the single monomial 2 x).
+*/
+func TestMonomial_Plus11(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+
+	// Test
+	for _, sum := range []symbolic.Expression{
+		x.ToMonomial().Plus(x),
+		x.ToMonomial().Plus(x.ToMonomial()),
+	} {
+		sumAsP, tf := sum.(symbolic.Polynomial)
+		if !tf {
+			t.Errorf(
+				"expected sum to be a polynomial; received %T",
+				sum,
+			)
+			continue
+		}
+
+		if len(sumAsP.Monomials) != 1 {
+			t.Errorf(
+				"expected sum to have 1 monomial; received %v",
+				len(sumAsP.Monomials),
+			)
+			continue
+		}
+
+		if sumAsP.Monomials[0].Coefficient != 2.0 {
+			t.Errorf(
+				"expected sum to have coefficient 2; received %v",
+				sumAsP.Monomials[0].Coefficient,
+			)
+		}
+	}
+}
+
 /*
 TestMonomial_Minus1
 Description:
@@ -1604,6 +1807,45 @@ func TestMonomial_DerivativeWrt5(t *testing.T) {
 	}
 }
 
+/*
+TestMonomial_MatchesFormOf1
+Description:
+
+	Verifies that the MatchesFormOf method returns true for two monomials
+	that contain the same variables and exponents in a different order
+	(i.e., x^1 y^2 and y^2 x^1) and false when the exponents differ.
+*/
+func TestMonomial_MatchesFormOf1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+
+	m1 := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{1, 2},
+	}
+	m2 := symbolic.Monomial{
+		Coefficient:     3.0,
+		VariableFactors: []symbolic.Variable{y, x},
+		Exponents:       []int{2, 1},
+	}
+	m3 := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{y, x},
+		Exponents:       []int{1, 2},
+	}
+
+	// Test
+	if !m1.MatchesFormOf(m2) {
+		t.Errorf("expected %v to match the form of %v", m1, m2)
+	}
+
+	if m1.MatchesFormOf(m3) {
+		t.Errorf("expected %v to not match the form of %v", m1, m3)
+	}
+}
+
 /*
 TestMonomial_String1
 Description:
@@ -1668,3 +1910,395 @@ func TestMonomial_String2(t *testing.T) {
 
 	_ = m1.String()
 }
+
+/*
+TestMonomial_Divide1
+Description:
+
+	Verifies that dividing 6 x^3 y^2 by 2 x y with the Divide method
+	gives 3 x^2 y.
+*/
+func TestMonomial_Divide1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	m1 := symbolic.Monomial{
+		Coefficient:     6.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{3, 2},
+	}
+	m2 := symbolic.Monomial{
+		Coefficient:     2.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{1, 1},
+	}
+	expected := symbolic.Monomial{
+		Coefficient:     3.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{2, 1},
+	}
+
+	// Test
+	quotient, err := m1.Divide(m2)
+	if err != nil {
+		t.Errorf("expected Divide to succeed; received error %v", err)
+	}
+
+	if quotient.Coefficient != 3.0 || !quotient.MatchesFormOf(expected) {
+		t.Errorf("expected %v / %v to be %v; received %v", m1, m2, expected, quotient)
+	}
+}
+
+/*
+TestMonomial_Divide2
+Description:
+
+	Verifies that the Divide method returns an error when the division is
+	not exact (x y / x^2) or when the divisor contains a variable that the
+	dividend does not (x / y).
+*/
+func TestMonomial_Divide2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	xy := x.Multiply(y).(symbolic.Monomial)
+
+	// Test
+	_, err := xy.Divide(x.Power(2).(symbolic.Monomial))
+	if err == nil {
+		t.Errorf("expected Divide to return an error for a non-exact division; received nil")
+	}
+
+	_, err = x.ToMonomial().Divide(y.ToMonomial())
+	if err == nil {
+		t.Errorf("expected Divide to return an error for a missing variable; received nil")
+	}
+}
+
+/*
+TestMonomial_Divide3
+Description:
+
+	Verifies that dividing by a constant monomial with the Divide method only
+	scales the coefficient and that dividing a monomial by itself gives the
+	constant monomial 1.
+*/
+func TestMonomial_Divide3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	m1 := symbolic.Monomial{
+		Coefficient:     5.0,
+		VariableFactors: []symbolic.Variable{x},
+		Exponents:       []int{2},
+	}
+
+	// Test
+	quotient, err := m1.Divide(symbolic.K(2.0).ToMonomial())
+	if err != nil {
+		t.Errorf("expected Divide to succeed; received error %v", err)
+	}
+
+	if quotient.Coefficient != 2.5 || !quotient.MatchesFormOf(m1) {
+		t.Errorf("expected %v / 2 to be 2.5 x^2; received %v", m1, quotient)
+	}
+
+	one, err := m1.Divide(m1)
+	if err != nil {
+		t.Errorf("expected Divide to succeed; received error %v", err)
+	}
+
+	if !one.IsConstant() || one.Coefficient != 1.0 {
+		t.Errorf("expected %v / %v to be 1; received %v", m1, m1, one)
+	}
+
+	_, err = m1.Divide(symbolic.K(0.0).ToMonomial())
+	if err == nil {
+		t.Errorf("expected Divide to return an error for a zero divisor; received nil")
+	}
+}
+
+/*
+TestMonomial_SubstituteAccordingTo1
+Description:
+
+	Verifies that SubstituteAccordingTo replaces each variable of the
+	monomial 2 x y according to the map, i.e., x -> 3 and y -> z
+	gives 6 z.
+*/
+func TestMonomial_SubstituteAccordingTo1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+	m := x.Multiply(y).Multiply(2.0).(symbolic.Monomial)
+
+	// Test
+	subbed := m.SubstituteAccordingTo(map[symbolic.Variable]symbolic.Expression{
+		x: symbolic.K(3.0),
+		y: z,
+	})
+
+	expected := z.Multiply(6.0).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(subbed.(symbolic.ScalarExpression), expected, 1e-10) {
+		t.Errorf("expected %v to become %v; received %v", m, expected, subbed)
+	}
+}
+
+/*
+TestMonomial_Equals1
+Description:
+
+	Verifies that Equals ignores the order of the variable factors but not
+	the coefficient.
+*/
+func TestMonomial_Equals1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	m1 := symbolic.Monomial{
+		Coefficient:     3.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{2, 1},
+	}
+	m2 := symbolic.Monomial{
+		Coefficient:     3.0,
+		VariableFactors: []symbolic.Variable{y, x},
+		Exponents:       []int{1, 2},
+	}
+
+	// Test
+	if !m1.Equals(m2) {
+		t.Errorf("Expected %v to equal %v; it did not", m1, m2)
+	}
+
+	m2.Coefficient = 3.5
+	if m1.Equals(m2) {
+		t.Errorf("Expected %v to not equal %v; it did", m1, m2)
+	}
+}
+
+/*
+TestMonomial_Canonicalize1
+Description:
+
+	Verifies that a monomial with the variable factors [x, x] and exponents
+	[1, 2] canonicalizes to a single factor x with exponent 3.
+*/
+func TestMonomial_Canonicalize1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	m1 := symbolic.Monomial{
+		Coefficient:     2.0,
+		VariableFactors: []symbolic.Variable{x, x},
+		Exponents:       []int{1, 2},
+	}
+
+	// Test
+	canonical := m1.Canonicalize()
+	if err := canonical.Check(); err != nil {
+		t.Errorf("expected the canonical monomial to be well-defined; received %v", err)
+	}
+
+	if len(canonical.VariableFactors) != 1 || canonical.VariableFactors[0].ID != x.ID {
+		t.Errorf(
+			"expected the canonical monomial to have the single factor %v; received %v",
+			x,
+			canonical.VariableFactors,
+		)
+	}
+
+	if len(canonical.Exponents) != 1 || canonical.Exponents[0] != 3 {
+		t.Errorf(
+			"expected the canonical monomial to have the exponents [3]; received %v",
+			canonical.Exponents,
+		)
+	}
+
+	if canonical.Coefficient != 2.0 {
+		t.Errorf(
+			"expected the canonical monomial to have coefficient 2; received %v",
+			canonical.Coefficient,
+		)
+	}
+}
+
+/*
+TestMonomial_Canonicalize2
+Description:
+
+	Verifies that Canonicalize sorts the variable factors of the monomial
+	y^2 x z^3 (x, y, z created in that order) by ID without modifying the
+	original monomial.
+*/
+func TestMonomial_Canonicalize2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+	m1 := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{y, x, z},
+		Exponents:       []int{2, 1, 3},
+	}
+
+	// Test
+	canonical := m1.Canonicalize()
+	expectedIDs := []uint64{x.ID, y.ID, z.ID}
+	expectedExponents := []int{1, 2, 3}
+	for ii := range expectedIDs {
+		if canonical.VariableFactors[ii].ID != expectedIDs[ii] || canonical.Exponents[ii] != expectedExponents[ii] {
+			t.Errorf(
+				"expected factor %v of the canonical monomial to be %v^%v; received %v^%v",
+				ii,
+				expectedIDs[ii], expectedExponents[ii],
+				canonical.VariableFactors[ii].ID, canonical.Exponents[ii],
+			)
+		}
+	}
+
+	if m1.VariableFactors[0].ID != y.ID {
+		t.Errorf("expected Canonicalize to leave the original monomial unchanged")
+	}
+}
+
+/*
+TestMonomial_SubstituteAccordingTo2
+Description:
+
+	Verifies that substituting x -> y and y -> x into x^2 y gives y^2 x. The
+	substitution is repeated many times, since a result which depends on the
+	order of the map would not always be the same.
+*/
+func TestMonomial_SubstituteAccordingTo2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	m := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{2, 1},
+	}
+	subMap := map[symbolic.Variable]symbolic.Expression{x: y, y: x}
+	expected := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{y, x},
+		Exponents:       []int{2, 1},
+	}.ToPolynomial()
+
+	// Test
+	for ii := 0; ii < 50; ii++ {
+		var result symbolic.Polynomial
+		switch r := m.SubstituteAccordingTo(subMap).(type) {
+		case symbolic.Variable:
+			result = r.ToPolynomial()
+		case symbolic.Monomial:
+			result = r.ToPolynomial()
+		case symbolic.Polynomial:
+			result = r
+		default:
+			t.Fatalf("expected a polynomial-like expression; received %T", r)
+		}
+
+		if !result.Equals(expected) {
+			t.Fatalf("expected %v; received %v", expected, result)
+		}
+	}
+}
+
+/*
+TestMonomial_SubstituteAccordingTo3
+Description:
+
+	Verifies that substituting x -> x + y and y -> 1 into x^2 y gives
+	(x + y)^2, i.e., the y in the replacement of x is not substituted again.
+*/
+func TestMonomial_SubstituteAccordingTo3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	m := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{2, 1},
+	}
+	subMap := map[symbolic.Variable]symbolic.Expression{
+		x: x.Plus(y),
+		y: symbolic.K(1.0),
+	}
+	expected := x.Plus(y).(symbolic.Polynomial).Power(2).(symbolic.Polynomial)
+
+	// Test
+	for ii := 0; ii < 50; ii++ {
+		var result symbolic.Polynomial
+		switch r := m.SubstituteAccordingTo(subMap).(type) {
+		case symbolic.Variable:
+			result = r.ToPolynomial()
+		case symbolic.Monomial:
+			result = r.ToPolynomial()
+		case symbolic.Polynomial:
+			result = r
+		default:
+			t.Fatalf("expected a polynomial-like expression; received %T", r)
+		}
+
+		if !result.Equals(expected) {
+			t.Fatalf("expected %v; received %v", expected, result)
+		}
+	}
+}
+
+/*
+TestMonomial_StringSortedByName1
+Description:
+
+	Verifies that StringSortedByName writes a variable without a name as x_<ID>
+	(like String does) instead of as an empty string.
+*/
+func TestMonomial_StringSortedByName1(t *testing.T) {
+	// Constants
+	m1 := symbolic.Monomial{
+		Coefficient:     2.0,
+		VariableFactors: []symbolic.Variable{{ID: 99}},
+		Exponents:       []int{1},
+	}
+
+	// Test
+	if m1.StringSortedByName() != "2 x_99" {
+		t.Errorf(
+			"expected StringSortedByName to return \"2 x_99\"; received \"%v\"",
+			m1.StringSortedByName(),
+		)
+	}
+
+	if m1.StringSortedByName() != m1.String() {
+		t.Errorf(
+			"expected StringSortedByName to match String (%v) for a single factor; received %v",
+			m1.String(),
+			m1.StringSortedByName(),
+		)
+	}
+}
+
+/*
+TestMonomial_StringSortedByName2
+Description:
+
+	Verifies that StringSortedByName sorts named and unnamed variables by the
+	same string that it prints (e.g., "b" comes before "x_5").
+*/
+func TestMonomial_StringSortedByName2(t *testing.T) {
+	// Constants
+	m1 := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{{ID: 5}, {ID: 6, Name: "b"}},
+		Exponents:       []int{2, 1},
+	}
+
+	// Test
+	if m1.StringSortedByName() != "b x_5^2" {
+		t.Errorf(
+			"expected StringSortedByName to return \"b x_5^2\"; received \"%v\"",
+			m1.StringSortedByName(),
+		)
+	}
+}
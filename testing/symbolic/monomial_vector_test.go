@@ -1842,3 +1842,131 @@ func TestMonomialVector_Power2(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestMonomialVector_ToKVector1
+Description:
+
+	Verifies that the ToKVector method returns the coefficients of a
+	monomial vector whose elements are all constant.
+*/
+func TestMonomialVector_ToKVector1(t *testing.T) {
+	// Constants
+	mv := symbolic.MonomialVector{
+		symbolic.K(2.5).ToMonomial(),
+		symbolic.K(-1.0).ToMonomial(),
+		symbolic.K(0.0).ToMonomial(),
+	}
+
+	// Test
+	kv, err := mv.ToKVector()
+	if err != nil {
+		t.Errorf("Expected ToKVector to succeed; received error %v", err)
+	}
+
+	expected := symbolic.KVector{2.5, -1.0, 0.0}
+	if kv.Len() != expected.Len() {
+		t.Errorf("Expected KVector of length %v; received %v", expected.Len(), kv.Len())
+	}
+
+	for ii, elt := range expected {
+		if kv[ii] != elt {
+			t.Errorf(
+				"Expected element %v of the KVector to be %v; received %v",
+				ii,
+				elt,
+				kv[ii],
+			)
+		}
+	}
+}
+
+/*
+TestMonomialVector_ToKVector2
+Description:
+
+	Verifies that the ToKVector method returns an error pointing at the
+	first element of the monomial vector that contains a variable.
+*/
+func TestMonomialVector_ToKVector2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	mv := symbolic.MonomialVector{
+		symbolic.K(1.0).ToMonomial(),
+		symbolic.K(2.0).ToMonomial(),
+		x.ToMonomial(),
+		y.ToMonomial(),
+	}
+
+	// Test
+	_, err := mv.ToKVector()
+	if err == nil {
+		t.Errorf("Expected ToKVector to return an error; received nil")
+	} else if !strings.Contains(err.Error(), "element 2") {
+		t.Errorf(
+			"Expected error to reference element 2; received %v",
+			err,
+		)
+	}
+}
+
+/*
+TestMonomialVector_DerivativeWrt1
+Description:
+
+	Verifies that the derivative of (x^2, x y) with respect to x is the
+	MonomialVector (2 x, y), and that the derivative with respect to a variable
+	that does not appear is a vector of zeros.
+*/
+func TestMonomialVector_DerivativeWrt1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+	mv := symbolic.MonomialVector{
+		x.Power(2).(symbolic.Monomial),
+		x.Multiply(y).(symbolic.Monomial),
+	}
+
+	// Test
+	derivative := mv.DerivativeWrt(x)
+	derivativeAsMV, ok := derivative.(symbolic.MonomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected mv.DerivativeWrt(x) to be a MonomialVector; received %T",
+			derivative,
+		)
+	}
+
+	expected := []symbolic.ScalarExpression{
+		x.Multiply(2.0).(symbolic.ScalarExpression),
+		y,
+	}
+	for ii, elt := range expected {
+		if !symbolic.AreEqual(derivativeAsMV[ii], elt, 1e-12) {
+			t.Errorf(
+				"Expected element %v of mv.DerivativeWrt(x) to be %v; received %v",
+				ii, elt, derivativeAsMV[ii],
+			)
+		}
+	}
+
+	zero := mv.DerivativeWrt(z)
+	zeroAsKV, ok := zero.(symbolic.KVector)
+	if !ok {
+		t.Errorf(
+			"Expected mv.DerivativeWrt(z) to be a KVector; received %T",
+			zero,
+		)
+	}
+
+	for ii, elt := range zeroAsKV {
+		if elt != 0.0 {
+			t.Errorf(
+				"Expected element %v of mv.DerivativeWrt(z) to be 0; received %v",
+				ii, elt,
+			)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package symbolic_test
+
+/*
+parameter_test.go
+Description:
+	Tests for the functions mentioned in the parameter.go file.
+*/
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestParameter_NewParameter1
+Description:
+
+	Verifies that NewParameter creates a valid variable which is marked as
+	a parameter, while NewVariable does not.
+*/
+func TestParameter_NewParameter1(t *testing.T) {
+	// Constants
+	p := symbolic.NewParameter()
+	x := symbolic.NewVariable()
+
+	// Test
+	if err := p.Check(); err != nil {
+		t.Errorf("Expected the parameter to be valid; received error %v", err)
+	}
+
+	if !p.IsParameter {
+		t.Errorf("Expected NewParameter to create a parameter; received %v", p)
+	}
+
+	if x.IsParameter {
+		t.Errorf("Expected NewVariable to not create a parameter; received %v", x)
+	}
+}
+
+/*
+TestParameter_DecisionVariables1
+Description:
+
+	Verifies that, while Variables() returns all four symbols of the expression
+	a x + b y, DecisionVariables (Variables() with the parameters excluded) only
+	returns x and y, and that Parameters only returns a and b.
+*/
+func TestParameter_DecisionVariables1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	a := symbolic.NewParameter()
+	b := symbolic.NewParameter()
+	e := a.Multiply(x).Plus(b.Multiply(y))
+
+	// Test
+	if len(e.Variables()) != 4 {
+		t.Errorf("Expected Variables() to include the parameters; received %v", e.Variables())
+	}
+
+	decisionVars := symbolic.DecisionVariables(e)
+	if len(decisionVars) != 2 {
+		t.Errorf("Expected 2 decision variables; received %v", decisionVars)
+	}
+
+	for _, v := range decisionVars {
+		if v.ID != x.ID && v.ID != y.ID {
+			t.Errorf("Expected DecisionVariables to only contain %v and %v; received %v", x, y, decisionVars)
+		}
+	}
+
+	params := symbolic.Parameters(e)
+	if len(params) != 2 {
+		t.Errorf("Expected 2 parameters; received %v", params)
+	}
+
+	for _, p := range params {
+		if p.ID != a.ID && p.ID != b.ID {
+			t.Errorf("Expected Parameters to only contain %v and %v; received %v", a, b, params)
+		}
+	}
+}
+
+/*
+TestParameter_EvaluateWithParameters1
+Description:
+
+	Verifies that EvaluateWithParameters evaluates a x^2 + b using the
+	assignment for x and the parameter map for a and b.
+*/
+func TestParameter_EvaluateWithParameters1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	a := symbolic.NewParameter()
+	b := symbolic.NewParameter()
+	e := a.Multiply(x.Power(2)).Plus(b).(symbolic.ScalarExpression)
+
+	assignment := map[symbolic.Variable]float64{x: 3.0}
+	parameters := map[symbolic.Variable]float64{a: 2.0, b: -1.0}
+
+	// Test
+	value, err := symbolic.EvaluateWithParameters(e, assignment, parameters)
+	if err != nil {
+		t.Errorf("Expected EvaluateWithParameters to succeed; received error %v", err)
+	}
+
+	if value != 17.0 {
+		t.Errorf("Expected %v to evaluate to 17; received %v", e, value)
+	}
+}
+
+/*
+TestParameter_EvaluateWithParameters2
+Description:
+
+	Verifies that EvaluateWithParameters returns an error when a parameter
+	is only given in the assignment (and not in the parameter map).
+*/
+func TestParameter_EvaluateWithParameters2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	a := symbolic.NewParameter()
+	e := a.Multiply(x).(symbolic.ScalarExpression)
+
+	assignment := map[symbolic.Variable]float64{x: 3.0, a: 2.0}
+	parameters := map[symbolic.Variable]float64{}
+
+	// Test
+	_, err := symbolic.EvaluateWithParameters(e, assignment, parameters)
+	if err == nil {
+		t.Errorf("Expected EvaluateWithParameters to return an error; received nil")
+	} else if !strings.Contains(err.Error(), "parameter") {
+		t.Errorf("Expected the error to mention the missing parameter; received %v", err)
+	}
+}
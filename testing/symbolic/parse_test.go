@@ -0,0 +1,117 @@
+package symbolic_test
+
+/*
+parse_test.go
+Description:
+	Tests for the functions mentioned in the parse.go file.
+*/
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestParse_ParsePolynomial1
+Description:
+
+	Verifies that ParsePolynomial parses "2*x^2 + 3*x - 1" into the
+	polynomial 2 x^2 + 3 x - 1.
+*/
+func TestParse_ParsePolynomial1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	vars := map[string]symbolic.Variable{"x": x}
+
+	// Test
+	p, err := symbolic.ParsePolynomial("2*x^2 + 3*x - 1", vars)
+	if err != nil {
+		t.Errorf("expected ParsePolynomial to succeed; received error %v", err)
+	}
+
+	expected := x.Power(2).Multiply(2.0).Plus(x.Multiply(3.0)).Plus(-1.0).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(p, expected, 1e-10) {
+		t.Errorf("expected %v; received %v", expected, p)
+	}
+
+	if len(p.Monomials) != 3 {
+		t.Errorf("expected 3 monomials; received %v", p)
+	}
+}
+
+/*
+TestParse_ParsePolynomial2
+Description:
+
+	Verifies that ParsePolynomial handles nested parentheses and unary
+	minus, i.e., "-(x - (y + 2))*(x + y)" is (y + 2 - x)(x + y).
+*/
+func TestParse_ParsePolynomial2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	vars := map[string]symbolic.Variable{"x": x, "y": y}
+
+	// Test
+	p, err := symbolic.ParsePolynomial("-(x - (y + 2))*(x + y)", vars)
+	if err != nil {
+		t.Errorf("expected ParsePolynomial to succeed; received error %v", err)
+	}
+
+	expected := y.Plus(2.0).Minus(x).Multiply(x.Plus(y)).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(p, expected, 1e-10) {
+		t.Errorf("expected %v; received %v", expected, p)
+	}
+}
+
+/*
+TestParse_ParsePolynomial3
+Description:
+
+	Verifies that ParsePolynomial returns descriptive errors for an
+	undefined variable name and for malformed input.
+*/
+func TestParse_ParsePolynomial3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	vars := map[string]symbolic.Variable{"x": x}
+
+	// Test
+	_, err := symbolic.ParsePolynomial("x + z", vars)
+	if err == nil || !strings.Contains(err.Error(), "unknown variable z") {
+		t.Errorf("expected an unknown variable error; received %v", err)
+	}
+
+	for _, malformed := range []string{"x +", "(x + 1", "x ^ y", "x $ 2", "2 x"} {
+		_, err = symbolic.ParsePolynomial(malformed, vars)
+		if err == nil {
+			t.Errorf("expected ParsePolynomial(%q) to return an error; received nil", malformed)
+		}
+	}
+}
+
+/*
+TestParse_ParsePolynomial4
+Description:
+
+	Verifies that ParsePolynomial accepts numbers in exponent notation,
+	i.e., "1e3*x + 2.5E-1*x^2 - 4e+0" is 1000 x + 0.25 x^2 - 4.
+*/
+func TestParse_ParsePolynomial4(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	vars := map[string]symbolic.Variable{"x": x}
+
+	// Test
+	p, err := symbolic.ParsePolynomial("1e3*x + 2.5E-1*x^2 - 4e+0", vars)
+	if err != nil {
+		t.Fatalf("expected ParsePolynomial to succeed; received error %v", err)
+	}
+
+	expected := x.Multiply(1000.0).Plus(x.Power(2).Multiply(0.25)).Plus(-4.0).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(p, expected, 1e-10) {
+		t.Errorf("expected %v; received %v", expected, p)
+	}
+}
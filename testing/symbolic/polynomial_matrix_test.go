@@ -1710,6 +1710,181 @@ func TestPolynomialMatrix_At2(t *testing.T) {
 	pm1.At(3, 0)
 }
 
+/*
+TestPolynomialMatrix_Slice1
+Description:
+
+	Verifies that the Slice method extracts the 2x2 submatrix containing rows
+	[0,2] and columns [2,1] (in that order) from a 3x3 polynomial matrix.
+*/
+func TestPolynomialMatrix_Slice1(t *testing.T) {
+	// Constants
+	pm := symbolic.NewVariableMatrix(3, 3).ToPolynomialMatrix()
+	rows, cols := []int{0, 2}, []int{2, 1}
+
+	// Test
+	sub, err := pm.Slice(rows, cols)
+	if err != nil {
+		t.Errorf("Expected Slice to succeed; received error %v", err)
+	}
+
+	if sub.Dims()[0] != 2 || sub.Dims()[1] != 2 {
+		t.Errorf("Expected the submatrix to be 2x2; received %v", sub.Dims())
+	}
+
+	for ii, rowIndex := range rows {
+		for jj, colIndex := range cols {
+			if !symbolic.AreEqual(sub[ii][jj], pm[rowIndex][colIndex], 0.0) {
+				t.Errorf(
+					"Expected entry (%v,%v) of the submatrix to be %v; received %v",
+					ii, jj,
+					pm[rowIndex][colIndex],
+					sub[ii][jj],
+				)
+			}
+		}
+	}
+}
+
+/*
+TestPolynomialMatrix_Slice2
+Description:
+
+	Verifies that the Slice method returns an error when one of the
+	indices is out of range.
+*/
+func TestPolynomialMatrix_Slice2(t *testing.T) {
+	// Constants
+	pm := symbolic.NewVariableMatrix(3, 3).ToPolynomialMatrix()
+
+	// Test
+	_, err := pm.Slice([]int{0, 3}, []int{1})
+	if err == nil {
+		t.Errorf("Expected Slice to return an error; received nil")
+	}
+
+	if _, tf := err.(smErrors.InvalidMatrixIndexError); !tf {
+		t.Errorf("Expected an InvalidMatrixIndexError; received %T", err)
+	}
+}
+
+/*
+TestPolynomialMatrix_LinearCoeff1
+Description:
+
+	Verifies that LinearCoeff of the affine matrix [[2 x, y + 1], [3, -y]]
+	with respect to (x, y) maps the variables onto the column-major
+	vectorization of the matrix, i.e. gives [[2, 0], [0, 0], [0, 1], [0, -1]].
+*/
+func TestPolynomialMatrix_LinearCoeff1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		{x.Multiply(2.0).(symbolic.Monomial).ToPolynomial(), y.Plus(1.0).(symbolic.Polynomial)},
+		{symbolic.K(3.0).ToPolynomial(), y.Multiply(-1.0).(symbolic.Monomial).ToPolynomial()},
+	}
+
+	// Test
+	L := pm.LinearCoeff([]symbolic.Variable{x, y})
+
+	expected := mat.NewDense(4, 2, []float64{
+		2.0, 0.0,
+		0.0, 0.0,
+		0.0, 1.0,
+		0.0, -1.0,
+	})
+	if !mat.EqualApprox(&L, expected, 1e-14) {
+		t.Errorf(
+			"expected pm.LinearCoeff() to be %v; received %v",
+			mat.Formatted(expected),
+			mat.Formatted(&L),
+		)
+	}
+}
+
+/*
+TestPolynomialMatrix_LinearCoeff2
+Description:
+
+	Verifies that LinearCoeff panics when one of the entries of the matrix
+	has degree greater than 1.
+*/
+func TestPolynomialMatrix_LinearCoeff2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		{x.ToPolynomial(), x.Power(2).(symbolic.Monomial).ToPolynomial()},
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"expected pm.LinearCoeff() to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok {
+			t.Errorf(
+				"expected pm.LinearCoeff() to panic with an error; received %v",
+				r,
+			)
+		}
+
+		if !strings.Contains(rAsE.Error(), "only affine matrices are supported") {
+			t.Errorf(
+				"expected pm.LinearCoeff() to panic about a non-affine entry; received %v",
+				rAsE,
+			)
+		}
+	}()
+
+	pm.LinearCoeff()
+}
+
+/*
+TestPolynomialMatrix_LinearCoeff3
+Description:
+
+	Verifies that LinearCoeff panics with a CanNotGetLinearCoeffOfConstantError
+	when the matrix contains no variables.
+*/
+func TestPolynomialMatrix_LinearCoeff3(t *testing.T) {
+	// Constants
+	pm := symbolic.DenseToKMatrix(symbolic.OnesMatrix(2, 2)).ToPolynomialMatrix()
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"expected pm.LinearCoeff() to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok {
+			t.Errorf(
+				"expected pm.LinearCoeff() to panic with an error; received %v",
+				r,
+			)
+		}
+
+		expectedError := smErrors.CanNotGetLinearCoeffOfConstantError{Expression: pm}
+		if rAsE.Error() != expectedError.Error() {
+			t.Errorf(
+				"expected pm.LinearCoeff() to panic with error %v; received %v",
+				expectedError, rAsE,
+			)
+		}
+	}()
+
+	pm.LinearCoeff()
+}
+
 /*
 TestPolynomialMatrix_Constant1
 Description:
@@ -1761,6 +1936,36 @@ func TestPolynomialMatrix_Constant2(t *testing.T) {
 	}
 }
 
+/*
+TestPolynomialMatrix_Constant3
+Description:
+
+	Tests that the Constant() method extracts the constant part of each
+	entry of a polynomial matrix that mixes constant and non-constant
+	polynomials, i.e. [[x + 2, 3], [x y, -1.5 + y]] gives [[2, 3], [0, -1.5]].
+*/
+func TestPolynomialMatrix_Constant3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		{x.Plus(2.0).(symbolic.Polynomial), symbolic.K(3.0).ToPolynomial()},
+		{x.Multiply(y).(symbolic.Monomial).ToPolynomial(), y.Plus(-1.5).(symbolic.Polynomial)},
+	}
+
+	// Test
+	constant0 := pm.Constant()
+
+	expected := mat.NewDense(2, 2, []float64{2.0, 3.0, 0.0, -1.5})
+	if !mat.EqualApprox(&constant0, expected, 1e-14) {
+		t.Errorf(
+			"expected pm.Constant() to be %v; received %v",
+			mat.Formatted(expected),
+			mat.Formatted(&constant0),
+		)
+	}
+}
+
 /*
 TestPolynomialMatrix_String1
 Description:
@@ -1794,3 +1999,313 @@ func TestPolynomialMatrix_String1(t *testing.T) {
 
 	}
 }
+
+/*
+TestPolynomialMatrix_MaxDegreePerVariable1
+Description:
+
+	Verifies that MaxDegreePerVariable reports a maximum degree of 2 for x
+	and 1 for y in the matrix [[x + y, x^2], [x y, 3]], and that no other
+	variables are included.
+*/
+func TestPolynomialMatrix_MaxDegreePerVariable1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+
+	pm := symbolic.PolynomialMatrix{
+		{
+			x.Plus(y).(symbolic.Polynomial),
+			x.Power(2).(symbolic.Monomial).ToPolynomial(),
+		},
+		{
+			x.Multiply(y).(symbolic.Monomial).ToPolynomial(),
+			symbolic.K(3.0).ToPolynomial(),
+		},
+	}
+
+	// Test
+	degrees := pm.MaxDegreePerVariable()
+	if len(degrees) != 2 {
+		t.Errorf("Expected 2 variables in the degree profile; received %v", degrees)
+	}
+
+	if degrees[x] != 2 {
+		t.Errorf("Expected the maximum degree of %v to be 2; received %v", x, degrees[x])
+	}
+
+	if degrees[y] != 1 {
+		t.Errorf("Expected the maximum degree of %v to be 1; received %v", y, degrees[y])
+	}
+
+	if _, tf := degrees[z]; tf {
+		t.Errorf("Expected %v to not be in the degree profile; received %v", z, degrees)
+	}
+}
+
+/*
+TestPolynomialMatrix_ToScalar1
+Description:
+
+	Verifies that ToScalar returns an error when the matrix is not 1x1.
+*/
+func TestPolynomialMatrix_ToScalar1(t *testing.T) {
+	// Constants
+	pm := symbolic.NewVariableMatrix(2, 1).ToPolynomialMatrix()
+
+	// Test
+	_, err := pm.ToScalar()
+	if err == nil {
+		t.Errorf("Expected ToScalar to return an error for a 2x1 matrix; received nil")
+	}
+}
+
+/*
+TestPolynomialMatrix_Degree1
+Description:
+
+	Verifies that the Degree() method returns the maximum degree of the
+	elements of a mixed-degree PolynomialMatrix and that IsQuadratic
+	uses this degree.
+*/
+func TestPolynomialMatrix_Degree1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		{x.ToPolynomial(), x.Multiply(y).(symbolic.Monomial).ToPolynomial()},
+		{symbolic.K(2.0).ToPolynomial(), y.Plus(3.0).(symbolic.Polynomial)},
+	}
+
+	// Test
+	if pm.Degree() != 2 {
+		t.Errorf("Expected pm.Degree() to be 2; received %v", pm.Degree())
+	}
+
+	if !symbolic.IsQuadratic(pm) {
+		t.Errorf("Expected IsQuadratic(pm) to be true")
+	}
+
+	if symbolic.IsLinear(pm) {
+		t.Errorf("Expected IsLinear(pm) to be false")
+	}
+}
+
+/*
+TestPolynomialMatrix_IsConstant1
+Description:
+
+	Verifies that IsConstant returns true for a matrix of constant polynomials
+	and false for a matrix that mixes constant and non-constant entries.
+*/
+func TestPolynomialMatrix_IsConstant1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	constantPM := symbolic.DenseToKMatrix(symbolic.OnesMatrix(2, 2)).ToPolynomialMatrix()
+	mixedPM := symbolic.PolynomialMatrix{
+		{symbolic.K(1.0).ToPolynomial(), x.ToPolynomial()},
+	}
+
+	// Test
+	if !constantPM.IsConstant() {
+		t.Errorf(
+			"Expected constantPM.IsConstant() to be true for %v; received false",
+			constantPM,
+		)
+	}
+
+	if mixedPM.IsConstant() {
+		t.Errorf(
+			"Expected mixedPM.IsConstant() to be false for %v; received true",
+			mixedPM,
+		)
+	}
+}
+
+/*
+TestPolynomialMatrix_UniqueMonomials1
+Description:
+
+	Verifies that a 2 x 2 matrix whose entries only use the monomial shapes
+	x y and x^2 (with different coefficients) has exactly two unique monomials.
+*/
+func TestPolynomialMatrix_UniqueMonomials1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	xy := x.Multiply(y).(symbolic.Monomial)
+	x2 := x.Power(2).(symbolic.Monomial)
+
+	scaled := func(m symbolic.Monomial, c float64) symbolic.Monomial {
+		out := m.Copy()
+		out.Coefficient = c
+		return out
+	}
+
+	pm := symbolic.PolynomialMatrix{
+		{
+			symbolic.Polynomial{Monomials: []symbolic.Monomial{scaled(xy, 2.0), scaled(x2, -1.0)}},
+			symbolic.Polynomial{Monomials: []symbolic.Monomial{scaled(x2, 3.0)}},
+		},
+		{
+			symbolic.Polynomial{Monomials: []symbolic.Monomial{scaled(xy, 5.0)}},
+			symbolic.Polynomial{Monomials: []symbolic.Monomial{scaled(x2, 0.5), scaled(xy, -4.0)}},
+		},
+	}
+
+	// Test
+	unique := pm.UniqueMonomials()
+	if len(unique) != 2 {
+		t.Errorf(
+			"Expected pm.UniqueMonomials() to have 2 monomials; received %v (%v)",
+			len(unique), unique,
+		)
+	}
+
+	for _, shape := range []symbolic.Monomial{xy, x2} {
+		found := false
+		for _, m := range unique {
+			found = found || m.MatchesFormOf(shape)
+		}
+
+		if !found {
+			t.Errorf(
+				"Expected pm.UniqueMonomials() to contain %v; received %v",
+				shape, unique,
+			)
+		}
+	}
+
+	for _, m := range unique {
+		if m.Coefficient != 1.0 {
+			t.Errorf(
+				"Expected each unique monomial to have coefficient 1; received %v",
+				m,
+			)
+		}
+	}
+}
+
+/*
+TestPolynomialMatrix_DerivativeWrt4
+Description:
+
+	Verifies that the derivative of a polynomial matrix with respect to a
+	variable that does not appear in it is a matrix of zeros of the same shape.
+*/
+func TestPolynomialMatrix_DerivativeWrt4(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		{x.Plus(1.0).(symbolic.Polynomial), x.ToPolynomial()},
+	}
+
+	// Test
+	derivative := pm.DerivativeWrt(z).(symbolic.MatrixExpression)
+	if derivative.Dims()[0] != 1 || derivative.Dims()[1] != 2 {
+		t.Errorf(
+			"Expected pm.DerivativeWrt(z) to have dimensions 1 x 2; received %v",
+			derivative.Dims(),
+		)
+	}
+
+	for jj := 0; jj < 2; jj++ {
+		if !symbolic.AreEqual(derivative.At(0, jj), symbolic.K(0.0), 1e-12) {
+			t.Errorf(
+				"Expected element (0,%v) of pm.DerivativeWrt(z) to be 0; received %v",
+				jj, derivative.At(0, jj),
+			)
+		}
+	}
+}
+
+/*
+TestPolynomialMatrix_CooTriplets1
+Description:
+
+	Verifies that CooTriplets only emits the nonzero coefficients of a sparse
+	affine matrix, with the same indices as the dense matrix from LinearCoeff.
+*/
+func TestPolynomialMatrix_CooTriplets1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		{x.Plus(1.0).(symbolic.Polynomial), symbolic.K(0.0).ToPolynomial()},
+		{symbolic.K(3.0).ToPolynomial(), y.Multiply(2.0).(symbolic.Monomial).Minus(x).(symbolic.Polynomial)},
+	}
+	wrt := []symbolic.Variable{x, y, z}
+
+	// Test
+	rows, cols, vals := pm.CooTriplets(wrt)
+
+	expectedRows := []int{0, 3, 3}
+	expectedCols := []int{0, 0, 1}
+	expectedVals := []float64{1.0, -1.0, 2.0}
+	if len(rows) != len(expectedRows) || len(cols) != len(expectedCols) || len(vals) != len(expectedVals) {
+		t.Fatalf(
+			"expected %v triplets; received rows %v, cols %v, vals %v",
+			len(expectedRows), rows, cols, vals,
+		)
+	}
+
+	for kk := range expectedRows {
+		if rows[kk] != expectedRows[kk] || cols[kk] != expectedCols[kk] || vals[kk] != expectedVals[kk] {
+			t.Errorf(
+				"expected triplet %v to be (%v, %v, %v); received (%v, %v, %v)",
+				kk,
+				expectedRows[kk], expectedCols[kk], expectedVals[kk],
+				rows[kk], cols[kk], vals[kk],
+			)
+		}
+	}
+
+	// Compare with the dense coefficient matrix
+	L := pm.LinearCoeff(wrt)
+	for kk := range rows {
+		if L.At(rows[kk], cols[kk]) != vals[kk] {
+			t.Errorf(
+				"expected triplet %v to match LinearCoeff entry (%v,%v) = %v; received %v",
+				kk, rows[kk], cols[kk], L.At(rows[kk], cols[kk]), vals[kk],
+			)
+		}
+	}
+}
+
+/*
+TestPolynomialMatrix_CooTriplets2
+Description:
+
+	Verifies that CooTriplets panics when one of the entries of the matrix
+	has degree greater than 1.
+*/
+func TestPolynomialMatrix_CooTriplets2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		{x.ToPolynomial(), x.Power(2).(symbolic.Monomial).ToPolynomial()},
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"expected pm.CooTriplets() to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok || !strings.Contains(rAsE.Error(), "only affine matrices are supported") {
+			t.Errorf(
+				"expected pm.CooTriplets() to panic with an error about affine matrices; received %v",
+				r,
+			)
+		}
+	}()
+
+	pm.CooTriplets([]symbolic.Variable{x})
+}
@@ -11,6 +11,7 @@ import (
 	getKVector "github.com/MatProGo-dev/SymbolicMath.go/get/KVector"
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"math/rand"
 	"reflect"
 	"strings"
 	"testing"
@@ -833,6 +834,164 @@ func TestPolynomial_Minus5(t *testing.T) {
 	p1.Minus("string")
 }
 
+/*
+TestPolynomial_Minus6
+Description:
+
+	Verifies that the Polynomial.Minus() method subtracts a K from the
+	constant term of the polynomial (rather than adding a new monomial).
+*/
+func TestPolynomial_Minus6(t *testing.T) {
+	// Constants
+	p1 := symbolic.NewVariable().ToPolynomial().Plus(5.0).(symbolic.Polynomial)
+
+	// Test
+	diff := p1.Minus(symbolic.K(2.0))
+	diffAsP, ok := diff.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf("expected Minus to return a Polynomial; received %T", diff)
+	}
+
+	if len(diffAsP.Monomials) != 2 {
+		t.Errorf(
+			"expected %v - %v to have 2 monomials; received %v",
+			p1,
+			2.0,
+			len(diffAsP.Monomials),
+		)
+	}
+
+	if diffAsP.Constant() != 3.0 {
+		t.Errorf(
+			"expected the constant of %v - %v to be 3; received %v",
+			p1,
+			2.0,
+			diffAsP.Constant(),
+		)
+	}
+}
+
+/*
+TestPolynomial_Minus7
+Description:
+
+	Verifies that the Polynomial.Minus() method combines a subtracted
+	monomial with the matching monomial of the polynomial, i.e.,
+	(3 x^2 + y) - x^2 = 2 x^2 + y.
+*/
+func TestPolynomial_Minus7(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	x2 := x.Power(2).(symbolic.Monomial)
+	p1 := x2.Multiply(3.0).(symbolic.Monomial).ToPolynomial().Plus(y).(symbolic.Polynomial)
+
+	// Test
+	diff := p1.Minus(x2)
+	diffAsP, ok := diff.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf("expected Minus to return a Polynomial; received %T", diff)
+	}
+
+	if len(diffAsP.Monomials) != 2 {
+		t.Errorf(
+			"expected %v - %v to have 2 monomials; received %v",
+			p1,
+			x2,
+			len(diffAsP.Monomials),
+		)
+	}
+
+	x2Index := diffAsP.MonomialIndex(x2)
+	if diffAsP.Monomials[x2Index].Coefficient != 2.0 {
+		t.Errorf(
+			"expected the coefficient of %v in the difference to be 2; received %v",
+			x2,
+			diffAsP.Monomials[x2Index].Coefficient,
+		)
+	}
+}
+
+/*
+TestPolynomial_Minus8
+Description:
+
+	Verifies that subtracting a polynomial from itself with the
+	Polynomial.Minus() method yields the zero polynomial.
+*/
+func TestPolynomial_Minus8(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p1 := x.Multiply(y).(symbolic.Monomial).ToPolynomial().Plus(x).Plus(1.0).(symbolic.Polynomial)
+
+	// Test
+	diff := p1.Minus(p1)
+	diffAsP, ok := diff.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf("expected Minus to return a Polynomial; received %T", diff)
+	}
+
+	for _, monomial := range diffAsP.Monomials {
+		if monomial.Coefficient != 0.0 {
+			t.Errorf(
+				"expected %v - %v to contain no nonzero monomials; received %v",
+				p1,
+				p1,
+				diffAsP,
+			)
+		}
+	}
+
+	if !diffAsP.IsConstant() || diffAsP.Constant() != 0.0 {
+		t.Errorf("expected %v - %v to be zero; received %v", p1, p1, diffAsP)
+	}
+}
+
+/*
+TestPolynomial_Minus9
+Description:
+
+	Verifies that the Polynomial.Minus() method broadcasts the polynomial
+	when subtracting a VariableVector, producing a PolynomialVector of
+	the same length, and when subtracting a KMatrix, producing a
+	PolynomialMatrix of the same shape.
+*/
+func TestPolynomial_Minus9(t *testing.T) {
+	// Constants
+	p1 := symbolic.NewVariable().ToPolynomial()
+	vv := symbolic.NewVariableVector(3)
+	km := symbolic.DenseToKMatrix(symbolic.OnesMatrix(2, 2))
+
+	// Test
+	diffV := p1.Minus(vv)
+	pv, ok := diffV.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf("expected Minus to return a PolynomialVector; received %T", diffV)
+	}
+
+	if pv.Len() != 3 {
+		t.Errorf("expected the difference to have length 3; received %v", pv.Len())
+	}
+
+	for ii := 0; ii < pv.Len(); ii++ {
+		expected := p1.Plus(vv[ii].Multiply(-1.0)).(symbolic.ScalarExpression)
+		if !symbolic.AreEqual(pv[ii], expected, 1e-10) {
+			t.Errorf("expected element %v to be %v; received %v", ii, expected, pv[ii])
+		}
+	}
+
+	diffM := p1.Minus(km)
+	pm, ok := diffM.(symbolic.PolynomialMatrix)
+	if !ok {
+		t.Errorf("expected Minus to return a PolynomialMatrix; received %T", diffM)
+	}
+
+	if pm.Dims()[0] != 2 || pm.Dims()[1] != 2 {
+		t.Errorf("expected the difference to have dimensions [2,2]; received %v", pm.Dims())
+	}
+}
+
 /*
 TestPolynomial_ConstantMonomialIndex1
 Description:
@@ -1893,6 +2052,233 @@ func TestPolynomial_Simplify2(t *testing.T) {
 	}
 }
 
+/*
+TestPolynomial_Simplify3
+Description:
+
+	Verifies that the Polynomial.Simplify method combines a polynomial
+	built by adding x to itself (as two separate monomials) into a single
+	monomial with coefficient 2.
+*/
+func TestPolynomial_Simplify3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{x.ToMonomial(), x.ToMonomial()},
+	}
+
+	// Test
+	simp := p1.Simplify()
+	if len(simp.Monomials) != 1 {
+		t.Errorf(
+			"expected %v to simplify to a single monomial; received %v",
+			p1,
+			simp,
+		)
+	}
+
+	if simp.Monomials[0].Coefficient != 2.0 {
+		t.Errorf(
+			"expected the coefficient of the simplified monomial to be 2; received %v",
+			simp.Monomials[0].Coefficient,
+		)
+	}
+}
+
+/*
+TestPolynomial_Simplify4
+Description:
+
+	Verifies that the Polynomial.Simplify method drops terms that cancel out
+	(i.e., x y - y x + 3 simplifies to 3) and that it sorts the variable
+	factors of each monomial into a canonical order.
+*/
+func TestPolynomial_Simplify4(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			{Coefficient: 1.0, VariableFactors: []symbolic.Variable{x, y}, Exponents: []int{1, 2}},
+			{Coefficient: -1.0, VariableFactors: []symbolic.Variable{y, x}, Exponents: []int{2, 1}},
+			symbolic.K(3.0).ToMonomial(),
+		},
+	}
+	p2 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			{Coefficient: 1.0, VariableFactors: []symbolic.Variable{y, x}, Exponents: []int{2, 1}},
+		},
+	}
+
+	// Test
+	simp1 := p1.Simplify()
+	if len(simp1.Monomials) != 1 || !simp1.IsConstant() || simp1.Constant() != 3.0 {
+		t.Errorf("expected %v to simplify to 3; received %v", p1, simp1)
+	}
+
+	simp2 := p2.Simplify()
+	if simp2.Monomials[0].VariableFactors[0].ID != x.ID {
+		t.Errorf(
+			"expected the first factor of %v to be %v; received %v",
+			simp2.Monomials[0],
+			x,
+			simp2.Monomials[0].VariableFactors[0],
+		)
+	}
+
+	if simp2.Monomials[0].Exponents[0] != 1 || simp2.Monomials[0].Exponents[1] != 2 {
+		t.Errorf(
+			"expected the exponents of %v to be [1 2]; received %v",
+			simp2.Monomials[0],
+			simp2.Monomials[0].Exponents,
+		)
+	}
+
+	// The original polynomial should not be modified
+	if p2.Monomials[0].VariableFactors[0].ID != y.ID {
+		t.Errorf("expected Simplify to leave the original polynomial unchanged")
+	}
+}
+
+/*
+TestPolynomial_Simplify5
+Description:
+
+	Verifies that the Polynomial.Simplify method drops a 0*x term from
+	3 + 0*x, and that a polynomial made up only of 0*x simplifies to the
+	zero polynomial (which still passes Check).
+*/
+func TestPolynomial_Simplify5(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	zeroX := symbolic.Monomial{
+		Coefficient:     0.0,
+		VariableFactors: []symbolic.Variable{x},
+		Exponents:       []int{1},
+	}
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{symbolic.K(3.0).ToMonomial(), zeroX},
+	}
+	p2 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{zeroX},
+	}
+
+	// Test
+	simp1 := p1.Simplify()
+	if len(simp1.Monomials) != 1 || !simp1.IsConstant() || simp1.Constant() != 3.0 {
+		t.Errorf("expected %v to simplify to 3; received %v", p1, simp1)
+	}
+
+	simp2 := p2.Simplify()
+	if err := simp2.Check(); err != nil {
+		t.Errorf("expected %v to simplify to a well-defined polynomial; received error %v", p2, err)
+	}
+
+	if len(simp2.Monomials) != 1 || !simp2.IsConstant() || simp2.Constant() != 0.0 {
+		t.Errorf("expected %v to simplify to 0; received %v", p2, simp2)
+	}
+}
+
+/*
+TestPolynomial_Simplify6
+Description:
+
+	Verifies that the Polynomial.Simplify method merges the repeated factors of
+	the monomial x^1 * x^2 and then combines it with the monomial x^3.
+*/
+func TestPolynomial_Simplify6(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			{Coefficient: 1.0, VariableFactors: []symbolic.Variable{x, x}, Exponents: []int{1, 2}},
+			{Coefficient: 2.0, VariableFactors: []symbolic.Variable{x}, Exponents: []int{3}},
+		},
+	}
+
+	// Test
+	simp := p1.Simplify()
+	if len(simp.Monomials) != 1 {
+		t.Errorf("expected %v to simplify to a single monomial; received %v", p1, simp)
+	}
+
+	if simp.Monomials[0].Coefficient != 3.0 ||
+		len(simp.Monomials[0].Exponents) != 1 ||
+		simp.Monomials[0].Exponents[0] != 3 {
+		t.Errorf("expected %v to simplify to 3 x^3; received %v", p1, simp)
+	}
+}
+
+/*
+TestPolynomial_RemoveZeroTerms1
+Description:
+
+	Verifies that x + 1 - x - 1 simplifies (and has its zero terms removed)
+	to the zero polynomial in canonical form, i.e., a single constant
+	monomial with coefficient 0 which passes Check.
+*/
+func TestPolynomial_RemoveZeroTerms1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p1 := x.Plus(1.0).Minus(x).Minus(1.0)
+	p1AsP, ok := p1.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf("expected x + 1 - x - 1 to be a Polynomial; received %T", p1)
+	}
+
+	// Test
+	for _, zero := range []symbolic.Polynomial{
+		p1AsP.Simplify(),
+		p1AsP.RemoveZeroTerms(0.0),
+	} {
+		if err := zero.Check(); err != nil {
+			t.Errorf("expected the zero polynomial to be well-defined; received error %v", err)
+		}
+
+		if len(zero.Monomials) != 1 {
+			t.Errorf("expected the zero polynomial to have 1 monomial; received %v", zero)
+		}
+
+		if !zero.Monomials[0].IsConstant() || zero.Monomials[0].Coefficient != 0.0 {
+			t.Errorf("expected the zero polynomial to be the constant 0; received %v", zero)
+		}
+	}
+}
+
+/*
+TestPolynomial_RemoveZeroTerms2
+Description:
+
+	Verifies that RemoveZeroTerms removes monomials whose coefficients are
+	within the tolerance of zero, but keeps the others.
+*/
+func TestPolynomial_RemoveZeroTerms2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			x.ToMonomial().Multiply(1e-12).(symbolic.Monomial),
+			y.ToMonomial().Multiply(2.0).(symbolic.Monomial),
+			symbolic.K(-1e-11).ToMonomial(),
+		},
+	}
+
+	// Test
+	reduced := p1.RemoveZeroTerms(1e-9)
+	if len(reduced.Monomials) != 1 {
+		t.Errorf("expected 1 monomial to remain; received %v", reduced)
+	}
+
+	if reduced.Monomials[0].VariableFactors[0].ID != y.ID {
+		t.Errorf("expected the remaining monomial to be 2 y; received %v", reduced)
+	}
+
+	if len(p1.Monomials) != 3 {
+		t.Errorf("expected the original polynomial to be unchanged; received %v", p1)
+	}
+}
+
 /*
 TestPolynomial_DerivativeWrt1
 Description:
@@ -2203,3 +2589,1229 @@ func TestPolynomial_Substitute3(t *testing.T) {
 	// Call the Substitute method
 	p1.Substitute(v1, symbolic.NewVariable())
 }
+
+/*
+TestPolynomial_PowerCapped1
+Description:
+
+	Verifies that the Polynomial.PowerCapped method returns an error when
+	the expansion of (x + y + z)^4 (which has 15 terms) is capped at 10 terms.
+*/
+func TestPolynomial_PowerCapped1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+	p1 := x.Plus(y).Plus(z).(symbolic.Polynomial)
+
+	// Test
+	_, err := p1.PowerCapped(4, 10)
+	if err == nil {
+		t.Errorf(
+			"expected PowerCapped to return an error when the term count exceeds the cap; received nil",
+		)
+	}
+}
+
+/*
+TestPolynomial_PowerCapped2
+Description:
+
+	Verifies that the Polynomial.PowerCapped method returns the fully expanded
+	polynomial when the cap is not exceeded. (x + y)^2 should have 3 terms.
+*/
+func TestPolynomial_PowerCapped2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p1 := x.Plus(y).(symbolic.Polynomial)
+
+	// Test
+	p2, err := p1.PowerCapped(2, 10)
+	if err != nil {
+		t.Errorf("expected PowerCapped to succeed; received error %v", err)
+	}
+
+	if len(p2.Monomials) != 3 {
+		t.Errorf(
+			"expected (x + y)^2 to have 3 monomials; received %v",
+			len(p2.Monomials),
+		)
+	}
+
+	if p2.Degree() != 2 {
+		t.Errorf(
+			"expected (x + y)^2 to have degree 2; received %v",
+			p2.Degree(),
+		)
+	}
+}
+
+/*
+TestPolynomial_PowerCapped3
+Description:
+
+	Verifies that the Polynomial.PowerCapped method returns a
+	NegativeExponentError when given a negative exponent.
+*/
+func TestPolynomial_PowerCapped3(t *testing.T) {
+	// Constants
+	p1 := symbolic.NewVariable().ToPolynomial()
+
+	// Test
+	_, err := p1.PowerCapped(-1, 10)
+	if err == nil {
+		t.Errorf("expected PowerCapped to return an error; received nil")
+	} else if err.Error() != (smErrors.NegativeExponentError{Exponent: -1}).Error() {
+		t.Errorf(
+			"expected error %v; received %v",
+			smErrors.NegativeExponentError{Exponent: -1},
+			err,
+		)
+	}
+}
+
+/*
+TestPolynomial_ReduceIntegerCoefficients1
+Description:
+
+	Verifies that the ReduceIntegerCoefficients method reduces
+	4 x + 6 to 2 x + 3 with a factor of 2.
+*/
+func TestPolynomial_ReduceIntegerCoefficients1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			x.ToMonomial().Multiply(4.0).(symbolic.Monomial),
+			symbolic.K(6.0).ToMonomial(),
+		},
+	}
+
+	// Test
+	p2, factor := p1.ReduceIntegerCoefficients()
+	if factor != 2 {
+		t.Errorf("expected factor 2; received %v", factor)
+	}
+
+	if p2.Monomials[0].Coefficient != 2.0 || p2.Monomials[1].Coefficient != 3.0 {
+		t.Errorf(
+			"expected reduced polynomial to be 2 x + 3; received %v",
+			p2,
+		)
+	}
+
+	// Verify that the original polynomial is unchanged
+	if p1.Monomials[0].Coefficient != 4.0 || p1.Monomials[1].Coefficient != 6.0 {
+		t.Errorf(
+			"expected original polynomial to be unchanged; received %v",
+			p1,
+		)
+	}
+}
+
+/*
+TestPolynomial_ReduceIntegerCoefficients2
+Description:
+
+	Verifies that the ReduceIntegerCoefficients method returns the polynomial
+	unchanged with a factor of 1 when one of the coefficients is not an integer.
+*/
+func TestPolynomial_ReduceIntegerCoefficients2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			x.ToMonomial().Multiply(4.0).(symbolic.Monomial),
+			symbolic.K(6.5).ToMonomial(),
+		},
+	}
+
+	// Test
+	p2, factor := p1.ReduceIntegerCoefficients()
+	if factor != 1 {
+		t.Errorf("expected factor 1; received %v", factor)
+	}
+
+	if p2.Monomials[0].Coefficient != 4.0 || p2.Monomials[1].Coefficient != 6.5 {
+		t.Errorf(
+			"expected polynomial to be unchanged; received %v",
+			p2,
+		)
+	}
+}
+
+/*
+TestPolynomial_HomogeneousPart1
+Description:
+
+	Verifies that the HomogeneousPart method extracts x^2 + x y
+	from the polynomial 1 + x + x^2 + x y.
+*/
+func TestPolynomial_HomogeneousPart1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p1 := symbolic.K(1.0).ToPolynomial().Plus(x).Plus(x.Power(2)).Plus(x.Multiply(y)).(symbolic.Polynomial)
+
+	// Test
+	p2 := p1.HomogeneousPart(2)
+	expected := x.Power(2).Plus(x.Multiply(y)).(symbolic.Polynomial)
+	if !symbolic.AreEqual(p2, expected, 1e-9) {
+		t.Errorf(
+			"expected the homogeneous part of degree 2 of %v to be %v; received %v",
+			p1,
+			expected,
+			p2,
+		)
+	}
+}
+
+/*
+TestPolynomial_HomogeneousPart2
+Description:
+
+	Verifies that the HomogeneousPart method returns the zero polynomial
+	when no monomial has the requested degree.
+*/
+func TestPolynomial_HomogeneousPart2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p1 := x.Plus(3.0).(symbolic.Polynomial)
+
+	// Test
+	p2 := p1.HomogeneousPart(4)
+	if err := p2.Check(); err != nil {
+		t.Errorf("expected the result to be a valid polynomial; received error %v", err)
+	}
+
+	if !p2.IsConstant() || p2.Constant() != 0.0 {
+		t.Errorf("expected the result to be the zero polynomial; received %v", p2)
+	}
+}
+
+/*
+TestPolynomial_ReplaceMonomial1
+Description:
+
+	Verifies that the ReplaceMonomial method replaces x^2 with a fresh
+	variable w in the polynomial x^2 + x, producing w + x.
+*/
+func TestPolynomial_ReplaceMonomial1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	w := symbolic.NewVariable()
+	p1 := x.Power(2).Plus(x).(symbolic.Polynomial)
+	pattern := x.Power(2).(symbolic.Monomial)
+
+	// Test
+	p2 := p1.ReplaceMonomial(pattern, w)
+	expected := w.Plus(x).(symbolic.Polynomial)
+	if !symbolic.AreEqual(p2, expected, 1e-9) {
+		t.Errorf(
+			"expected %v with %v replaced by %v to be %v; received %v",
+			p1,
+			pattern,
+			w,
+			expected,
+			p2,
+		)
+	}
+}
+
+/*
+TestPolynomial_ReplaceMonomial2
+Description:
+
+	Verifies that the ReplaceMonomial method ignores the coefficient of the
+	pattern and scales the replacement by the coefficient of the matched monomial.
+	Replacing (5 x y) with w in 3 y x + 1 should give 3 w + 1.
+*/
+func TestPolynomial_ReplaceMonomial2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	w := symbolic.NewVariable()
+	p1 := y.Multiply(x).Multiply(3.0).Plus(1.0).(symbolic.Polynomial)
+	pattern := x.Multiply(y).Multiply(5.0).(symbolic.Monomial)
+
+	// Test
+	p2 := p1.ReplaceMonomial(pattern, w)
+	expected := w.Multiply(3.0).Plus(1.0).(symbolic.Polynomial)
+	if !symbolic.AreEqual(p2, expected, 1e-9) {
+		t.Errorf(
+			"expected %v with %v replaced by %v to be %v; received %v",
+			p1,
+			pattern,
+			w,
+			expected,
+			p2,
+		)
+	}
+}
+
+/*
+TestPolynomial_RandomPolynomial1
+Description:
+
+	Verifies that 1000 polynomials generated by RandomPolynomial all pass Check,
+	respect the maximum degree, and that p + q equals q + p for each consecutive pair.
+*/
+func TestPolynomial_RandomPolynomial1(t *testing.T) {
+	// Constants
+	rng := rand.New(rand.NewSource(493))
+	vars := symbolic.NewVariableVector(3)
+	maxMonomials, maxDegree := 5, 3
+
+	// Test
+	previous := symbolic.RandomPolynomial(vars, maxMonomials, maxDegree, rng)
+	for ii := 0; ii < 1000; ii++ {
+		p := symbolic.RandomPolynomial(vars, maxMonomials, maxDegree, rng)
+		if err := p.Check(); err != nil {
+			t.Errorf("expected random polynomial %v to be valid; received error %v", p, err)
+		}
+
+		if p.Degree() > maxDegree {
+			t.Errorf(
+				"expected random polynomial %v to have degree at most %v; received %v",
+				p,
+				maxDegree,
+				p.Degree(),
+			)
+		}
+
+		pq := p.Plus(previous).(symbolic.ScalarExpression)
+		qp := previous.Plus(p).(symbolic.ScalarExpression)
+		if !symbolic.AreEqual(pq, qp, 1e-9) {
+			t.Errorf(
+				"expected p + q to equal q + p for p = %v and q = %v; received %v and %v",
+				p,
+				previous,
+				pq,
+				qp,
+			)
+		}
+
+		previous = p
+	}
+}
+
+/*
+TestPolynomial_RandomPolynomial2
+Description:
+
+	Verifies that RandomPolynomial panics when maxMonomials is less than 1.
+*/
+func TestPolynomial_RandomPolynomial2(t *testing.T) {
+	// Constants
+	rng := rand.New(rand.NewSource(493))
+	vars := symbolic.NewVariableVector(2)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("expected RandomPolynomial to panic when maxMonomials is 0; received nil")
+		}
+	}()
+	symbolic.RandomPolynomial(vars, 0, 2, rng)
+}
+
+/*
+TestPolynomial_CoefficientInProduct1
+Description:
+
+	Verifies that CoefficientInProduct matches the coefficient found by
+	expanding the product (x + 2 y + 1) * (3 x - y + 4) with Multiply
+	for each monomial in the expanded product.
+*/
+func TestPolynomial_CoefficientInProduct1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	a := x.Plus(y.Multiply(2.0)).Plus(1.0).(symbolic.Polynomial)
+	b := x.Multiply(3.0).Plus(y.Multiply(-1.0)).Plus(4.0).(symbolic.Polynomial)
+
+	// Test
+	product := a.Multiply(b).(symbolic.Polynomial)
+	for _, monomial := range product.Monomials {
+		coefficient := symbolic.CoefficientInProduct(a, b, monomial)
+		if coefficient != monomial.Coefficient {
+			t.Errorf(
+				"expected coefficient of %v in (%v) * (%v) to be %v; received %v",
+				monomial,
+				a,
+				b,
+				monomial.Coefficient,
+				coefficient,
+			)
+		}
+	}
+
+	// Check the cross term (which gets contributions from two pairs)
+	xy := y.Multiply(x).(symbolic.Monomial)
+	if coefficient := symbolic.CoefficientInProduct(a, b, xy); coefficient != 5.0 {
+		t.Errorf("expected the coefficient of x y to be 5; received %v", coefficient)
+	}
+}
+
+/*
+TestPolynomial_CoefficientInProduct2
+Description:
+
+	Verifies that CoefficientInProduct returns 0 when the target
+	does not appear in the product.
+*/
+func TestPolynomial_CoefficientInProduct2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	a := x.Plus(1.0).(symbolic.Polynomial)
+	b := x.Plus(2.0).(symbolic.Polynomial)
+
+	// Test
+	coefficient := symbolic.CoefficientInProduct(a, b, y.ToMonomial())
+	if coefficient != 0.0 {
+		t.Errorf("expected the coefficient of %v to be 0; received %v", y, coefficient)
+	}
+}
+
+/*
+TestPolynomial_StringSortedByName1
+Description:
+
+	Verifies that StringSortedByName orders the factors and monomials of
+	c + 2 b a alphabetically by variable name, producing "2 a b + c".
+*/
+func TestPolynomial_StringSortedByName1(t *testing.T) {
+	// Constants
+	c := symbolic.NewVariable()
+	c.Name = "c"
+	b := symbolic.NewVariable()
+	b.Name = "b"
+	a := symbolic.NewVariable()
+	a.Name = "a"
+
+	p1 := c.ToPolynomial().Plus(b.Multiply(a).Multiply(2.0)).(symbolic.Polynomial)
+
+	// Test
+	if p1.StringSortedByName() != "2 a b + c" {
+		t.Errorf(
+			"expected StringSortedByName to return \"2 a b + c\"; received \"%v\"",
+			p1.StringSortedByName(),
+		)
+	}
+}
+
+/*
+TestPolynomial_StringSortedByName2
+Description:
+
+	Verifies that StringSortedByName places constants last and includes exponents,
+	producing "a^2 + 3 b + 4" for 4 + 3 b + a^2.
+*/
+func TestPolynomial_StringSortedByName2(t *testing.T) {
+	// Constants
+	a := symbolic.NewVariable()
+	a.Name = "a"
+	b := symbolic.NewVariable()
+	b.Name = "b"
+
+	p1 := symbolic.K(4.0).ToPolynomial().Plus(b.Multiply(3.0)).Plus(a.Power(2)).(symbolic.Polynomial)
+
+	// Test
+	if p1.StringSortedByName() != "a^2 + 3 b + 4" {
+		t.Errorf(
+			"expected StringSortedByName to return \"a^2 + 3 b + 4\"; received \"%v\"",
+			p1.StringSortedByName(),
+		)
+	}
+}
+
+/*
+TestPolynomial_ToMatrix1
+Description:
+
+	Verifies that wrapping a polynomial in a 1x1 matrix with ToMatrix and
+	unwrapping it with ToScalar recovers the original polynomial.
+*/
+func TestPolynomial_ToMatrix1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p1 := x.Multiply(y).Plus(x.Power(2)).Plus(3.0).(symbolic.Polynomial)
+
+	// Test
+	pm := p1.ToMatrix()
+	if pm.Dims()[0] != 1 || pm.Dims()[1] != 1 {
+		t.Errorf("expected ToMatrix to return a 1x1 matrix; received %v", pm.Dims())
+	}
+
+	p2, err := pm.ToScalar()
+	if err != nil {
+		t.Errorf("expected ToScalar to succeed; received error %v", err)
+	}
+
+	if !symbolic.AreEqual(p1, p2, 0.0) || len(p1.Monomials) != len(p2.Monomials) {
+		t.Errorf("expected round trip to recover %v; received %v", p1, p2)
+	}
+}
+
+/*
+TestPolynomial_Eval1
+Description:
+
+	Tests that the Eval method correctly evaluates the polynomial
+	3 x^2 y + 2 y + 1 at the point (x, y) = (2, -1), respecting the
+	exponents of each monomial.
+*/
+func TestPolynomial_Eval1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			{Coefficient: 3.0, VariableFactors: []symbolic.Variable{x, y}, Exponents: []int{2, 1}},
+			{Coefficient: 2.0, VariableFactors: []symbolic.Variable{y}, Exponents: []int{1}},
+			symbolic.K(1.0).ToMonomial(),
+		},
+	}
+
+	// Test
+	value, err := p.Eval(map[symbolic.Variable]float64{x: 2.0, y: -1.0})
+	if err != nil {
+		t.Errorf("Expected Eval to succeed; received error %v", err)
+	}
+
+	if value != -13.0 {
+		t.Errorf("Expected Eval to return -13; received %v", value)
+	}
+}
+
+/*
+TestPolynomial_Eval2
+Description:
+
+	Tests that the Eval method returns an error when a variable of the
+	polynomial is missing from the assignment.
+*/
+func TestPolynomial_Eval2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := x.Plus(y).(symbolic.Polynomial)
+
+	// Test
+	_, err := p.Eval(map[symbolic.Variable]float64{x: 2.0})
+	if err == nil {
+		t.Errorf("Expected Eval to return an error; received nil")
+	}
+}
+
+/*
+TestPolynomial_Power1
+Description:
+
+	Tests that the Power method expands (x + y)^2 into
+	x^2 + 2xy + y^2 with like terms combined.
+*/
+func TestPolynomial_Power1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := x.Plus(y).(symbolic.Polynomial)
+
+	expected := x.Power(2).Plus(
+		x.Multiply(y).Multiply(2.0),
+	).Plus(y.Power(2)).(symbolic.Polynomial)
+
+	// Test
+	result := p.Power(2)
+	resultAsP, ok := result.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf("Expected Power to return a Polynomial; received %T", result)
+	}
+
+	if len(resultAsP.Monomials) != 3 {
+		t.Errorf("Expected 3 monomials; received %v (%v)", len(resultAsP.Monomials), resultAsP)
+	}
+
+	if !symbolic.AreEqual(resultAsP, expected, 1e-10) {
+		t.Errorf("Expected (x+y)^2 to be %v; received %v", expected, resultAsP)
+	}
+}
+
+/*
+TestPolynomial_Power2
+Description:
+
+	Tests that the Power method expands (x + y + 1)^3 into a polynomial
+	with the 10 distinct monomials of degree at most 3, with the
+	multinomial coefficient 6 on the xy term.
+*/
+func TestPolynomial_Power2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := x.Plus(y).Plus(1.0).(symbolic.Polynomial)
+
+	// Test
+	result := p.Power(3).(symbolic.Polynomial)
+	if len(result.Monomials) != 10 {
+		t.Errorf("Expected 10 monomials; received %v (%v)", len(result.Monomials), result)
+	}
+
+	xy := x.Multiply(y).(symbolic.Monomial)
+	mIndex := result.MonomialIndex(xy)
+	if mIndex == -1 {
+		t.Errorf("Expected %v to contain the monomial %v", result, xy)
+	} else if result.Monomials[mIndex].Coefficient != 6.0 {
+		t.Errorf(
+			"Expected coefficient of xy to be 6; received %v",
+			result.Monomials[mIndex].Coefficient,
+		)
+	}
+
+	if result.Degree() != 3 {
+		t.Errorf("Expected degree 3; received %v", result.Degree())
+	}
+}
+
+/*
+TestPolynomial_Power3
+Description:
+
+	Tests that the Power method returns the constant polynomial 1 for an
+	exponent of 0, an independent copy for an exponent of 1, and panics
+	for a negative exponent.
+*/
+func TestPolynomial_Power3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p := x.Plus(2.0).(symbolic.Polynomial)
+
+	// Test
+	p0 := p.Power(0).(symbolic.Polynomial)
+	if !p0.IsConstant() || p0.Constant() != 1.0 {
+		t.Errorf("Expected p^0 to be 1; received %v", p0)
+	}
+
+	p1 := p.Power(1).(symbolic.Polynomial)
+	if !symbolic.AreEqual(p1, p, 0.0) {
+		t.Errorf("Expected p^1 to be %v; received %v", p, p1)
+	}
+
+	p1.Monomials[0].Coefficient = 10.0
+	if p.Monomials[0].Coefficient == 10.0 {
+		t.Errorf("Expected p^1 to be a copy of p")
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected Power to panic; received nil")
+		}
+
+		rAsE, ok := r.(error)
+		if !ok {
+			t.Errorf("Expected Power to panic with an error; received %v", r)
+		}
+
+		if _, ok := rAsE.(smErrors.NegativeExponentError); !ok {
+			t.Errorf("Expected a NegativeExponentError; received %T", rAsE)
+		}
+	}()
+
+	p.Power(-1)
+}
+
+/*
+TestPolynomial_IsConvexQuadratic1
+Description:
+
+	Tests that IsConvexQuadratic identifies x^2 + y^2 as convex and
+	x^2 - y^2 as not convex.
+*/
+func TestPolynomial_IsConvexQuadratic1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	wrt := []symbolic.Variable{x, y}
+
+	convexP := x.Power(2).Plus(y.Power(2)).(symbolic.Polynomial)
+	saddleP := x.Power(2).Minus(y.Power(2)).(symbolic.Polynomial)
+
+	// Test
+	isConvex, err := convexP.IsConvexQuadratic(wrt)
+	if err != nil {
+		t.Errorf("Expected IsConvexQuadratic to succeed; received error %v", err)
+	}
+
+	if !isConvex {
+		t.Errorf("Expected %v to be convex", convexP)
+	}
+
+	isConvex, err = saddleP.IsConvexQuadratic(wrt)
+	if err != nil {
+		t.Errorf("Expected IsConvexQuadratic to succeed; received error %v", err)
+	}
+
+	if isConvex {
+		t.Errorf("Expected %v to not be convex", saddleP)
+	}
+}
+
+/*
+TestPolynomial_IsConvexQuadratic2
+Description:
+
+	Tests that IsConvexQuadratic identifies (x - y)^2 (whose Hessian is
+	singular) as convex and returns an error for the cubic x^3.
+*/
+func TestPolynomial_IsConvexQuadratic2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	wrt := []symbolic.Variable{x, y}
+
+	p := x.Minus(y).(symbolic.Polynomial).Power(2).(symbolic.Polynomial)
+
+	// Test
+	isConvex, err := p.IsConvexQuadratic(wrt)
+	if err != nil {
+		t.Errorf("Expected IsConvexQuadratic to succeed; received error %v", err)
+	}
+
+	if !isConvex {
+		t.Errorf("Expected %v to be convex", p)
+	}
+
+	_, err = x.Power(3).(symbolic.Monomial).ToPolynomial().IsConvexQuadratic(wrt)
+	if err == nil {
+		t.Errorf("Expected IsConvexQuadratic to return an error; received nil")
+	}
+}
+
+/*
+TestPolynomial_SubstituteVector1
+Description:
+
+	Verifies that substituting the block of variables x = (x1, x2, x3) with the
+	PolynomialVector (y + 1, y, 2) in the polynomial x1 x2 + x3 gives the
+	polynomial y^2 + y + 2.
+*/
+func TestPolynomial_SubstituteVector1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(3)
+	y := symbolic.NewVariable()
+	p := x[0].Multiply(x[1]).Plus(x[2]).(symbolic.Polynomial)
+
+	replacement := symbolic.PolynomialVector{
+		y.Plus(1.0).(symbolic.Polynomial),
+		y.ToPolynomial(),
+		symbolic.K(2.0).ToPolynomial(),
+	}
+
+	// Test
+	result := p.SubstituteVector(x, replacement)
+	resultAsSE, ok := result.(symbolic.ScalarExpression)
+	if !ok {
+		t.Errorf(
+			"Expected p.SubstituteVector(x, replacement) to return a scalar expression; received %T",
+			result,
+		)
+	}
+
+	expected := y.Power(2).Plus(y).Plus(2.0).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(resultAsSE, expected, 1e-12) {
+		t.Errorf(
+			"Expected p.SubstituteVector(x, replacement) to be %v; received %v",
+			expected, resultAsSE,
+		)
+	}
+
+	for _, v := range resultAsSE.Variables() {
+		if v.ID != y.ID {
+			t.Errorf(
+				"Expected the result to only contain %v; found %v",
+				y, v,
+			)
+		}
+	}
+}
+
+/*
+TestPolynomial_SubstituteVector2
+Description:
+
+	Verifies that SubstituteVector panics when the vector of variables and the
+	replacement vector have different lengths.
+*/
+func TestPolynomial_SubstituteVector2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(3)
+	p := x[0].Plus(x[1]).(symbolic.Polynomial)
+	replacement := symbolic.NewVariableVector(2).ToPolynomialVector()
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected p.SubstituteVector(x, replacement) to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok {
+			t.Errorf(
+				"Expected p.SubstituteVector(x, replacement) to panic with an error; received %v",
+				r,
+			)
+		}
+
+		expectedError := smErrors.DimensionError{
+			Operation: "SubstituteVector",
+			Arg1:      x,
+			Arg2:      replacement,
+		}
+		if rAsE.Error() != expectedError.Error() {
+			t.Errorf(
+				"Expected p.SubstituteVector(x, replacement) to panic with error %v; received %v",
+				expectedError, rAsE,
+			)
+		}
+	}()
+
+	p.SubstituteVector(x, replacement)
+}
+
+/*
+TestPolynomial_SubstituteVector3
+Description:
+
+	Verifies that SubstituteVector panics when the vector of variables contains
+	the same variable twice.
+*/
+func TestPolynomial_SubstituteVector3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p := x.Plus(1.0).(symbolic.Polynomial)
+	in := symbolic.VariableVector{x, x}
+	replacement := symbolic.KVector{1.0, 2.0}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected p.SubstituteVector(in, replacement) to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok {
+			t.Errorf(
+				"Expected p.SubstituteVector(in, replacement) to panic with an error; received %v",
+				r,
+			)
+		}
+
+		if !strings.Contains(rAsE.Error(), "appears more than once") {
+			t.Errorf(
+				"Expected p.SubstituteVector(in, replacement) to panic about a repeated variable; received %v",
+				rAsE,
+			)
+		}
+	}()
+
+	p.SubstituteVector(in, replacement)
+}
+
+/*
+TestPolynomial_Collect1
+Description:
+
+	Verifies that collecting x^2 y + 3 x + 2 on x gives {2: y, 1: 3, 0: 2}.
+*/
+func TestPolynomial_Collect1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := x.Power(2).Multiply(y).Plus(x.Multiply(3.0)).Plus(2.0).(symbolic.Polynomial)
+
+	// Test
+	groups := p.Collect(x)
+	if len(groups) != 3 {
+		t.Errorf(
+			"Expected p.Collect(x) to have 3 groups; received %v",
+			len(groups),
+		)
+	}
+
+	expected := map[int]symbolic.ScalarExpression{
+		2: y,
+		1: symbolic.K(3.0),
+		0: symbolic.K(2.0),
+	}
+	for exponent, coefficient := range expected {
+		group, tf := groups[exponent]
+		if !tf {
+			t.Errorf(
+				"Expected p.Collect(x) to contain the exponent %v; received %v",
+				exponent, groups,
+			)
+			continue
+		}
+
+		if !symbolic.AreEqual(group, coefficient, 1e-12) {
+			t.Errorf(
+				"Expected the coefficient of x^%v to be %v; received %v",
+				exponent, coefficient, group,
+			)
+		}
+	}
+}
+
+/*
+TestPolynomial_Collect2
+Description:
+
+	Verifies that collecting a polynomial on a variable that does not appear in it
+	returns the whole polynomial under the key 0 by default, and panics when
+	strict mode is requested.
+*/
+func TestPolynomial_Collect2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := y.Multiply(2.0).Plus(1.0).(symbolic.Polynomial)
+
+	// Test (default)
+	groups := p.Collect(x)
+	if len(groups) != 1 {
+		t.Errorf(
+			"Expected p.Collect(x) to have 1 group; received %v",
+			len(groups),
+		)
+	}
+
+	if !symbolic.AreEqual(groups[0], p, 1e-12) {
+		t.Errorf(
+			"Expected p.Collect(x)[0] to be %v; received %v",
+			p, groups[0],
+		)
+	}
+
+	// Test (strict)
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected p.Collect(x, true) to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok {
+			t.Errorf(
+				"Expected p.Collect(x, true) to panic with an error; received %v",
+				r,
+			)
+		}
+
+		if !strings.Contains(rAsE.Error(), "does not appear in the polynomial") {
+			t.Errorf(
+				"Expected p.Collect(x, true) to panic about a missing variable; received %v",
+				rAsE,
+			)
+		}
+	}()
+
+	p.Collect(x, true)
+}
+
+/*
+TestPolynomial_SubstituteAccordingTo1
+Description:
+
+	Verifies that a variable in a polynomial can be replaced by a 1 x 1
+	PolynomialMatrix, which is treated as the scalar it contains: substituting
+	x = [[y + 1]] into x^2 + x gives y^2 + 3 y + 2.
+*/
+func TestPolynomial_SubstituteAccordingTo1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := x.Power(2).Plus(x).(symbolic.Polynomial)
+	replacement := symbolic.PolynomialMatrix{
+		{y.Plus(1.0).(symbolic.Polynomial)},
+	}
+
+	// Test
+	result := p.SubstituteAccordingTo(map[symbolic.Variable]symbolic.Expression{
+		x: replacement,
+	})
+	resultAsSE, ok := result.(symbolic.ScalarExpression)
+	if !ok {
+		t.Errorf(
+			"Expected the substitution to give a scalar expression; received %T",
+			result,
+		)
+	}
+
+	expected := y.Power(2).Plus(y.Multiply(3.0)).Plus(2.0).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(resultAsSE, expected, 1e-12) {
+		t.Errorf(
+			"Expected the substitution to give %v; received %v",
+			expected, resultAsSE,
+		)
+	}
+}
+
+/*
+TestPolynomial_SubstituteAccordingTo2
+Description:
+
+	Verifies that substituting a variable with a 2 x 1 vector expression
+	panics with an error saying that the value is not a scalar expression.
+*/
+func TestPolynomial_SubstituteAccordingTo2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p := x.Plus(1.0).(symbolic.Polynomial)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected the substitution to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok || !strings.Contains(rAsE.Error(), "is not a scalar expression") {
+			t.Errorf(
+				"Expected the substitution to panic about a non-scalar value; received %v",
+				r,
+			)
+		}
+	}()
+
+	p.SubstituteAccordingTo(map[symbolic.Variable]symbolic.Expression{
+		x: symbolic.NewVariableVector(2),
+	})
+}
+
+/*
+TestPolynomial_CoefficientOf1
+Description:
+
+	Verifies that the coefficient of x y in 3 x y + 2 x + 1 is 3 (regardless of
+	the coefficient of the monomial used to query it), that the coefficient of
+	the constant monomial is 1 and that the coefficient of the absent term y is 0.
+*/
+func TestPolynomial_CoefficientOf1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := x.Multiply(y).Multiply(3.0).Plus(x.Multiply(2.0)).Plus(1.0).(symbolic.Polynomial)
+
+	xy := x.Multiply(y).Multiply(7.0).(symbolic.Monomial)
+
+	// Test
+	testCases := []struct {
+		m        symbolic.Monomial
+		expected float64
+	}{
+		{m: xy, expected: 3.0},
+		{m: symbolic.K(1.0).ToMonomial(), expected: 1.0},
+		{m: y.ToMonomial(), expected: 0.0},
+	}
+	for _, tc := range testCases {
+		if c := p.CoefficientOf(tc.m); c != tc.expected {
+			t.Errorf(
+				"Expected p.CoefficientOf(%v) to be %v; received %v",
+				tc.m, tc.expected, c,
+			)
+		}
+	}
+}
+
+/*
+TestPolynomial_CoefficientOf2
+Description:
+
+	Verifies that CoefficientOf sums the coefficients of all matching monomials
+	when the polynomial has not been simplified.
+*/
+func TestPolynomial_CoefficientOf2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			x.Multiply(2.0).(symbolic.Monomial),
+			x.Multiply(-0.5).(symbolic.Monomial),
+		},
+	}
+
+	// Test
+	if c := p.CoefficientOf(x.ToMonomial()); c != 1.5 {
+		t.Errorf(
+			"Expected p.CoefficientOf(x) to be 1.5; received %v",
+			c,
+		)
+	}
+}
+
+/*
+TestPolynomial_Equals1
+Description:
+
+	Verifies that x + y equals y + x.
+*/
+func TestPolynomial_Equals1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+
+	xPlusY := x.Plus(y).(symbolic.Polynomial)
+	yPlusX := y.Plus(x).(symbolic.Polynomial)
+
+	// Test
+	if !xPlusY.Equals(yPlusX) {
+		t.Errorf(
+			"Expected %v to equal %v; it did not",
+			xPlusY, yPlusX,
+		)
+	}
+}
+
+/*
+TestPolynomial_Equals2
+Description:
+
+	Verifies that 2x does not equal x.
+*/
+func TestPolynomial_Equals2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	twoX := x.Multiply(2.0).(symbolic.Monomial).ToPolynomial()
+
+	// Test
+	if twoX.Equals(x.ToPolynomial()) {
+		t.Errorf(
+			"Expected %v to not equal %v; it did",
+			twoX, x,
+		)
+	}
+}
+
+/*
+TestPolynomial_Equals3
+Description:
+
+	Verifies that Equals compares polynomials after simplification, so that
+	unsimplified terms and zero terms do not matter.
+*/
+func TestPolynomial_Equals3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p1 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			x.ToMonomial(),
+			y.Multiply(0.0).(symbolic.Monomial),
+			x.ToMonomial(),
+		},
+	}
+	p2 := x.Multiply(2.0).(symbolic.Monomial).ToPolynomial()
+
+	// Test
+	if !p1.Equals(p2) {
+		t.Errorf(
+			"Expected %v to equal %v; it did not",
+			p1, p2,
+		)
+	}
+}
+
+/*
+TestPolynomial_SubstituteAccordingTo3
+Description:
+
+	Verifies that substituting x -> y and y -> x into x + y gives x + y
+	(no matter the order in which the map is traversed).
+*/
+func TestPolynomial_SubstituteAccordingTo3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := x.Plus(y).(symbolic.Polynomial)
+	subMap := map[symbolic.Variable]symbolic.Expression{x: y, y: x}
+
+	// Test
+	for ii := 0; ii < 50; ii++ {
+		var result symbolic.Polynomial
+		switch r := p.SubstituteAccordingTo(subMap).(type) {
+		case symbolic.Variable:
+			result = r.ToPolynomial()
+		case symbolic.Monomial:
+			result = r.ToPolynomial()
+		case symbolic.Polynomial:
+			result = r
+		default:
+			t.Fatalf("expected a polynomial-like expression; received %T", r)
+		}
+
+		if !result.Equals(p) {
+			t.Fatalf("expected %v; received %v", p, result)
+		}
+	}
+}
+
+/*
+TestPolynomial_SubstituteVector4
+Description:
+
+	Verifies that SubstituteVector can permute the variables of a polynomial,
+	i.e., substituting [x, y] -> [y, x] into x^2 y gives y^2 x, and that the
+	result is simplified (it has no zero terms).
+*/
+func TestPolynomial_SubstituteVector4(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{2, 1},
+	}.ToPolynomial()
+	in := symbolic.VariableVector{x, y}
+	replacement := symbolic.VariableVector{y, x}
+	expected := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{y, x},
+		Exponents:       []int{2, 1},
+	}.ToPolynomial()
+
+	// Test
+	for ii := 0; ii < 50; ii++ {
+		result, ok := p.SubstituteVector(in, replacement).(symbolic.Polynomial)
+		if !ok {
+			t.Fatalf(
+				"Expected p.SubstituteVector(in, replacement) to be a polynomial; received %T",
+				p.SubstituteVector(in, replacement),
+			)
+		}
+
+		if !result.Equals(expected) {
+			t.Fatalf("Expected %v; received %v", expected, result)
+		}
+
+		if len(result.Monomials) != 1 {
+			t.Fatalf("Expected the result to have 1 monomial; received %v", result)
+		}
+	}
+}
@@ -7,8 +7,12 @@ Description:
 */
 
 import (
+	"math"
+	"math/rand"
+
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
 	"strings"
 	"testing"
 )
@@ -732,6 +736,59 @@ func TestPolynomialVector_LinearCoeff6(t *testing.T) {
 	pv.LinearCoeff(vSlice3, vSlice3)
 }
 
+/*
+TestPolynomialVector_LinearCoeff7
+Description:
+
+	This test verifies that, for randomly generated linear polynomial vectors,
+	the LinearCoeff method agrees with the (evaluated) Jacobian of the vector
+	for several different orderings of the variables.
+*/
+func TestPolynomialVector_LinearCoeff7(t *testing.T) {
+	// Constants
+	rng := rand.New(rand.NewSource(492))
+	nTrials := 25
+	nVars, nRows := 4, 3
+	tol := 1e-9
+
+	for trial := 0; trial < nTrials; trial++ {
+		// Create a random linear polynomial vector
+		x := symbolic.NewVariableVector(nVars)
+		var pv symbolic.PolynomialVector
+		for ii := 0; ii < nRows; ii++ {
+			pII := symbolic.K(rng.NormFloat64()).ToPolynomial()
+			for _, xJJ := range x {
+				pII = pII.Plus(xJJ.Multiply(rng.NormFloat64())).(symbolic.Polynomial)
+			}
+			pv = append(pv, pII)
+		}
+
+		// Compare the two matrices across several variable orderings
+		for ordering := 0; ordering < 3; ordering++ {
+			vars := pv.Variables()
+			rng.Shuffle(len(vars), func(ii, jj int) {
+				vars[ii], vars[jj] = vars[jj], vars[ii]
+			})
+
+			linearCoeff := pv.LinearCoeff(vars)
+			jacobian := symbolic.Jacobian(pv, vars).Constant()
+
+			for ii := 0; ii < nRows; ii++ {
+				for jj := 0; jj < len(vars); jj++ {
+					if math.Abs(linearCoeff.At(ii, jj)-jacobian.At(ii, jj)) > tol {
+						t.Errorf(
+							"Expected LinearCoeff and Jacobian to agree at (%v,%v); received %v and %v",
+							ii, jj,
+							linearCoeff.At(ii, jj),
+							jacobian.At(ii, jj),
+						)
+					}
+				}
+			}
+		}
+	}
+}
+
 /*
 TestPolynomialVector_Plus1
 Description:
@@ -1902,6 +1959,76 @@ func TestPolynomialVector_GreaterEq1(t *testing.T) {
 	pv1.GreaterEq("test")
 }
 
+/*
+TestPolynomialVector_ToKVector1
+Description:
+
+	This test verifies that the ToKVector method returns the correct KVector
+	when every element of the polynomial vector is constant.
+*/
+func TestPolynomialVector_ToKVector1(t *testing.T) {
+	// Constants
+	pv := symbolic.PolynomialVector{
+		symbolic.K(1.5).ToPolynomial(),
+		symbolic.Polynomial{
+			Monomials: []symbolic.Monomial{
+				symbolic.K(2.0).ToMonomial(),
+				symbolic.K(3.0).ToMonomial(),
+			},
+		},
+		symbolic.K(-4.0).ToPolynomial(),
+	}
+
+	// Test
+	kv, err := pv.ToKVector()
+	if err != nil {
+		t.Errorf("Expected ToKVector to succeed; received error %v", err)
+	}
+
+	expected := symbolic.KVector{1.5, 5.0, -4.0}
+	if kv.Len() != expected.Len() {
+		t.Errorf("Expected KVector of length %v; received %v", expected.Len(), kv.Len())
+	}
+
+	for ii, elt := range expected {
+		if kv[ii] != elt {
+			t.Errorf(
+				"Expected element %v of the KVector to be %v; received %v",
+				ii,
+				elt,
+				kv[ii],
+			)
+		}
+	}
+}
+
+/*
+TestPolynomialVector_ToKVector2
+Description:
+
+	This test verifies that the ToKVector method returns an error
+	when one of the elements of the polynomial vector still contains a variable.
+*/
+func TestPolynomialVector_ToKVector2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		symbolic.K(1.5).ToPolynomial(),
+		x.Plus(3.0).(symbolic.Polynomial),
+	}
+
+	// Test
+	_, err := pv.ToKVector()
+	if err == nil {
+		t.Errorf("Expected ToKVector to return an error; received nil")
+	} else if !strings.Contains(err.Error(), "element 1") {
+		t.Errorf(
+			"Expected error to reference element 1; received %v",
+			err,
+		)
+	}
+}
+
 /*
 TestPolynomialVector_Simplify1
 Description:
@@ -1960,3 +2087,503 @@ func TestPolynomialVector_Simplify2(t *testing.T) {
 		}
 	}
 }
+
+/*
+TestPolynomialVector_Substitute1
+Description:
+
+	Tests that substituting a constant for x in the PolynomialVector
+	(x + y, 2 x) gives (y + 3, 6). Substituting constants for every
+	variable (with SubstituteAccordingTo) should collapse the result to a
+	KVector.
+*/
+func TestPolynomialVector_Substitute1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Plus(y).(symbolic.Polynomial),
+		x.Multiply(2.0).(symbolic.Monomial).ToPolynomial(),
+	}
+
+	// Test
+	subbed := pv.Substitute(x, symbolic.K(3.0)).(symbolic.VectorExpression)
+	expected := []symbolic.ScalarExpression{
+		y.Plus(3.0).(symbolic.ScalarExpression),
+		symbolic.K(6.0),
+	}
+	for ii, e := range expected {
+		if !symbolic.AreEqual(subbed.AtVec(ii), e, 1e-10) {
+			t.Errorf("Expected element %v to be %v; received %v", ii, e, subbed.AtVec(ii))
+		}
+	}
+
+	allSubbed := pv.SubstituteAccordingTo(map[symbolic.Variable]symbolic.Expression{
+		x: symbolic.K(3.0),
+		y: symbolic.K(-1.0),
+	})
+	kv, ok := allSubbed.(symbolic.KVector)
+	if !ok {
+		t.Errorf("Expected SubstituteAccordingTo to return a KVector; received %T", allSubbed)
+	}
+
+	if float64(kv[0]) != 2.0 || float64(kv[1]) != 6.0 {
+		t.Errorf("Expected (2, 6); received %v", kv)
+	}
+}
+
+/*
+TestPolynomialVector_Substitute2
+Description:
+
+	Tests that substituting another variable z for x in the PolynomialVector
+	(x^2 + y, x y) gives (z^2 + y, z y).
+*/
+func TestPolynomialVector_Substitute2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Power(2).Plus(y).(symbolic.Polynomial),
+		x.Multiply(y).(symbolic.Monomial).ToPolynomial(),
+	}
+
+	// Test
+	subbed := pv.Substitute(x, z)
+	subbedAsPV, ok := subbed.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf("Expected Substitute to return a PolynomialVector; received %T", subbed)
+	}
+
+	expected := []symbolic.ScalarExpression{
+		z.Power(2).Plus(y).(symbolic.ScalarExpression),
+		z.Multiply(y).(symbolic.ScalarExpression),
+	}
+	for ii, e := range expected {
+		if !symbolic.AreEqual(subbedAsPV[ii], e, 1e-10) {
+			t.Errorf("Expected element %v to be %v; received %v", ii, e, subbedAsPV[ii])
+		}
+
+		if symbolic.ContainsVariable(subbedAsPV[ii], x) {
+			t.Errorf("Expected element %v to no longer contain %v; received %v", ii, x, subbedAsPV[ii])
+		}
+	}
+}
+
+/*
+TestPolynomialVector_Degree1
+Description:
+
+	Verifies that the Degree() method panics when called with a
+	PolynomialVector that is not well-defined.
+*/
+func TestPolynomialVector_Degree1(t *testing.T) {
+	// Constants
+	pv := symbolic.PolynomialVector{
+		symbolic.NewVariable().ToPolynomial(),
+		symbolic.Polynomial{},
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected pv.Degree() to panic; received nil")
+		}
+	}()
+
+	pv.Degree()
+}
+
+/*
+TestPolynomialVector_Degree2
+Description:
+
+	Verifies that the Degree() method returns the maximum degree of the
+	elements of a mixed-degree PolynomialVector, that a constant
+	PolynomialVector has degree 0, and that IsLinear uses this degree.
+*/
+func TestPolynomialVector_Degree2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Plus(1.0).(symbolic.Polynomial),
+		x.Multiply(y).Multiply(y).(symbolic.Monomial).ToPolynomial(),
+		symbolic.K(3.0).ToPolynomial(),
+	}
+	constantPV := symbolic.VecDenseToKVector(symbolic.OnesVector(3)).ToPolynomialVector()
+
+	// Test
+	if pv.Degree() != 3 {
+		t.Errorf("Expected pv.Degree() to be 3; received %v", pv.Degree())
+	}
+
+	if symbolic.IsLinear(pv) {
+		t.Errorf("Expected IsLinear(pv) to be false for a degree 3 vector")
+	}
+
+	if constantPV.Degree() != 0 {
+		t.Errorf("Expected constantPV.Degree() to be 0; received %v", constantPV.Degree())
+	}
+
+	if !symbolic.IsLinear(constantPV) {
+		t.Errorf("Expected IsLinear(constantPV) to be true")
+	}
+}
+
+/*
+TestPolynomialVector_IsConstant1
+Description:
+
+	Verifies that IsConstant returns true for a polynomial vector whose
+	elements are all constants.
+*/
+func TestPolynomialVector_IsConstant1(t *testing.T) {
+	// Constants
+	pv := symbolic.KVector{1.0, -2.0, 3.5}.ToPolynomialVector()
+
+	// Test
+	if !pv.IsConstant() {
+		t.Errorf(
+			"Expected pv.IsConstant() to be true for %v; received false",
+			pv,
+		)
+	}
+}
+
+/*
+TestPolynomialVector_IsConstant2
+Description:
+
+	Verifies that IsConstant returns false for a polynomial vector that mixes
+	constant and non-constant elements.
+*/
+func TestPolynomialVector_IsConstant2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		symbolic.K(1.0).ToPolynomial(),
+		x.Plus(2.0).(symbolic.Polynomial),
+	}
+
+	// Test
+	if pv.IsConstant() {
+		t.Errorf(
+			"Expected pv.IsConstant() to be false for %v; received true",
+			pv,
+		)
+	}
+}
+
+/*
+TestPolynomialVector_AppendElement1
+Description:
+
+	Verifies that appending a Variable and then a K to an empty polynomial
+	vector gives a vector of length 2 containing those two expressions.
+*/
+func TestPolynomialVector_AppendElement1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	var pv symbolic.PolynomialVector
+
+	// Test
+	pv = pv.AppendElement(x).AppendElement(symbolic.K(3.0))
+	if pv.Len() != 2 {
+		t.Errorf(
+			"Expected pv to have length 2; received %v",
+			pv.Len(),
+		)
+	}
+
+	if !symbolic.AreEqual(pv[0], x, 1e-12) {
+		t.Errorf(
+			"Expected pv[0] to be %v; received %v",
+			x, pv[0],
+		)
+	}
+
+	if !symbolic.AreEqual(pv[1], symbolic.K(3.0), 1e-12) {
+		t.Errorf(
+			"Expected pv[1] to be 3; received %v",
+			pv[1],
+		)
+	}
+}
+
+/*
+TestPolynomialVector_Concat1
+Description:
+
+	Verifies that concatenating polynomial vectors of lengths 2 and 3 gives a
+	vector of length 5 without modifying either input.
+*/
+func TestPolynomialVector_Concat1(t *testing.T) {
+	// Constants
+	pv1 := symbolic.NewVariableVector(2).ToPolynomialVector()
+	pv2 := symbolic.KVector{1.0, 2.0, 3.0}.ToPolynomialVector()
+
+	// Test
+	pv3 := pv1.Concat(pv2)
+	if pv3.Len() != 5 {
+		t.Errorf(
+			"Expected pv1.Concat(pv2) to have length 5; received %v",
+			pv3.Len(),
+		)
+	}
+
+	if pv1.Len() != 2 || pv2.Len() != 3 {
+		t.Errorf(
+			"Expected the inputs to keep lengths 2 and 3; received %v and %v",
+			pv1.Len(), pv2.Len(),
+		)
+	}
+
+	for ii := 0; ii < pv3.Len(); ii++ {
+		var expected symbolic.ScalarExpression
+		if ii < 2 {
+			expected = pv1[ii]
+		} else {
+			expected = pv2[ii-2]
+		}
+
+		if !symbolic.AreEqual(pv3[ii], expected, 1e-12) {
+			t.Errorf(
+				"Expected element %v of pv1.Concat(pv2) to be %v; received %v",
+				ii, expected, pv3[ii],
+			)
+		}
+	}
+}
+
+/*
+TestPolynomialVector_Slice1
+Description:
+
+	Verifies that Slice(1, 3) of a polynomial vector returns a copy of the
+	middle elements which can be modified without changing the original.
+*/
+func TestPolynomialVector_Slice1(t *testing.T) {
+	// Constants
+	pv := symbolic.KVector{1.0, 2.0, 3.0, 4.0}.ToPolynomialVector()
+
+	// Test
+	sliced := pv.Slice(1, 3).(symbolic.PolynomialVector)
+	if sliced.Len() != 2 {
+		t.Errorf(
+			"Expected pv.Slice(1, 3) to have length 2; received %v",
+			sliced.Len(),
+		)
+	}
+
+	sliced[0].Monomials[0].Coefficient = 10.0
+	if pv[1].Monomials[0].Coefficient != 2.0 {
+		t.Errorf(
+			"Expected modifying the slice to leave pv unchanged; received %v",
+			pv,
+		)
+	}
+}
+
+/*
+TestPolynomialVector_UniqueMonomials1
+Description:
+
+	Verifies that the vector (x + 1, 2 x + 3) has the two unique monomial
+	shapes x and 1.
+*/
+func TestPolynomialVector_UniqueMonomials1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Plus(1.0).(symbolic.Polynomial),
+		x.Multiply(2.0).Plus(3.0).(symbolic.Polynomial),
+	}
+
+	// Test
+	unique := pv.UniqueMonomials()
+	if len(unique) != 2 {
+		t.Errorf(
+			"Expected pv.UniqueMonomials() to have 2 monomials; received %v (%v)",
+			len(unique), unique,
+		)
+	}
+}
+
+/*
+TestPolynomialVector_DerivativeWrt1
+Description:
+
+	Verifies that the derivative of (x^2 + y, 3 x y) with respect to x is
+	(2 x, 3 y) and that the original vector is left unchanged.
+*/
+func TestPolynomialVector_DerivativeWrt1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Power(2).Plus(y).(symbolic.Polynomial),
+		x.Multiply(y).Multiply(3.0).(symbolic.Monomial).ToPolynomial(),
+	}
+	original := pv[0].String()
+
+	// Test
+	derivative := pv.DerivativeWrt(x)
+	derivativeAsPV, ok := derivative.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected pv.DerivativeWrt(x) to be a PolynomialVector; received %T",
+			derivative,
+		)
+	}
+
+	expected := []symbolic.ScalarExpression{
+		x.Multiply(2.0).(symbolic.ScalarExpression),
+		y.Multiply(3.0).(symbolic.ScalarExpression),
+	}
+	for ii, elt := range expected {
+		if !symbolic.AreEqual(derivativeAsPV[ii], elt, 1e-12) {
+			t.Errorf(
+				"Expected element %v of pv.DerivativeWrt(x) to be %v; received %v",
+				ii, elt, derivativeAsPV[ii],
+			)
+		}
+	}
+
+	if pv[0].String() != original {
+		t.Errorf(
+			"Expected pv to be unchanged by DerivativeWrt; pv[0] changed from %v to %v",
+			original, pv[0],
+		)
+	}
+}
+
+/*
+TestPolynomialVector_DerivativeWrt2
+Description:
+
+	Verifies that the derivative of a polynomial vector with respect to a
+	variable that does not appear in it is a vector of zeros.
+*/
+func TestPolynomialVector_DerivativeWrt2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Plus(1.0).(symbolic.Polynomial),
+		x.Power(3).(symbolic.Monomial).ToPolynomial(),
+	}
+
+	// Test
+	derivative := pv.DerivativeWrt(z).(symbolic.VectorExpression)
+	if derivative.Len() != pv.Len() {
+		t.Errorf(
+			"Expected pv.DerivativeWrt(z) to have length %v; received %v",
+			pv.Len(), derivative.Len(),
+		)
+	}
+
+	for ii := 0; ii < derivative.Len(); ii++ {
+		if !symbolic.AreEqual(derivative.AtVec(ii), symbolic.K(0.0), 1e-12) {
+			t.Errorf(
+				"Expected element %v of pv.DerivativeWrt(z) to be 0; received %v",
+				ii, derivative.AtVec(ii),
+			)
+		}
+	}
+}
+
+/*
+TestPolynomialVector_Variables4
+Description:
+
+	Verifies that building the same polynomial vector twice (with its terms
+	added in different orders) yields identically ordered variable slices and
+	matching LinearCoeff columns.
+*/
+func TestPolynomialVector_Variables4(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+
+	pv1 := symbolic.PolynomialVector{
+		x.Plus(y.Multiply(2.0)).(symbolic.Polynomial),
+		y.Multiply(3.0).(symbolic.Monomial).Minus(z).(symbolic.Polynomial),
+	}
+	pv2 := symbolic.PolynomialVector{
+		y.Multiply(2.0).(symbolic.Monomial).Plus(x).(symbolic.Polynomial),
+		z.Multiply(-1.0).(symbolic.Monomial).Plus(y.Multiply(3.0)).(symbolic.Polynomial),
+	}
+
+	// Test
+	vars1, vars2 := pv1.Variables(), pv2.Variables()
+	if len(vars1) != 3 || len(vars2) != 3 {
+		t.Fatalf("expected both vectors to have 3 variables; received %v and %v", vars1, vars2)
+	}
+
+	for ii := range vars1 {
+		if vars1[ii].ID != vars2[ii].ID {
+			t.Errorf(
+				"expected variable %v of both vectors to match; received %v and %v",
+				ii, vars1[ii], vars2[ii],
+			)
+		}
+
+		if ii > 0 && vars1[ii-1].ID >= vars1[ii].ID {
+			t.Errorf("expected the variables to be sorted by ID; received %v", vars1)
+		}
+	}
+
+	L1, L2 := pv1.LinearCoeff(), pv2.LinearCoeff()
+	if !mat.Equal(&L1, &L2) {
+		t.Errorf(
+			"expected the LinearCoeff of both vectors to match; received %v and %v",
+			mat.Formatted(&L1),
+			mat.Formatted(&L2),
+		)
+	}
+}
+
+/*
+TestPolynomialVector_SubstituteVector1
+Description:
+
+	Verifies that SubstituteVector on a polynomial vector can swap its
+	variables, i.e., substituting [x, y] -> [y, x] into [x + 2 y, x y^2]
+	gives [y + 2 x, y x^2].
+*/
+func TestPolynomialVector_SubstituteVector1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Plus(y.Multiply(2.0)).(symbolic.Polynomial),
+		x.Multiply(y.Power(2)).(symbolic.Monomial).ToPolynomial(),
+	}
+	in := symbolic.VariableVector{x, y}
+	replacement := symbolic.VariableVector{y, x}
+	expected := []symbolic.Polynomial{
+		y.Plus(x.Multiply(2.0)).(symbolic.Polynomial),
+		y.Multiply(x.Power(2)).(symbolic.Monomial).ToPolynomial(),
+	}
+
+	// Test
+	for ii := 0; ii < 50; ii++ {
+		result, ok := pv.SubstituteVector(in, replacement).(symbolic.PolynomialVector)
+		if !ok {
+			t.Fatalf(
+				"Expected pv.SubstituteVector(in, replacement) to be a polynomial vector; received %T",
+				pv.SubstituteVector(in, replacement),
+			)
+		}
+
+		for jj := range expected {
+			if !result[jj].Equals(expected[jj]) {
+				t.Fatalf("Expected element %v to be %v; received %v", jj, expected[jj], result[jj])
+			}
+		}
+	}
+}
@@ -160,7 +160,7 @@ Description:
 
 	This function tests that the Simplify() method of a ScalarConstraint
 	formed between a variable on the left hand side and a constant on the
-	right.
+	right moves the constant to the left, giving x - 3.14 <= 0.
 */
 func TestScalarConstraint_Simplify1(t *testing.T) {
 	// Constants
@@ -181,18 +181,21 @@ func TestScalarConstraint_Simplify1(t *testing.T) {
 		)
 	}
 
-	// Verify that the left hand side is a variable
-	if _, ok := sc.Left().(symbolic.Variable); !ok {
+	// Verify that the left hand side is x - 3.14
+	expectedLeft := x.Minus(c2).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(sc.LeftHandSide, expectedLeft, 1e-12) {
 		t.Errorf(
-			"Expected sc.Left() to be a symbolic.Variable; received %T",
+			"Expected sc.Left() to be %v; received %v",
+			expectedLeft,
 			sc.Left(),
 		)
 	}
 
-	// Verify that the right hand side is a constant
-	if _, ok := sc.Right().(symbolic.K); !ok {
+	// Verify that the right hand side is zero
+	if rightAsK, ok := sc.Right().(symbolic.K); !ok || float64(rightAsK) != 0 {
 		t.Errorf(
-			"Expected sc.Right() to be a symbolic.K; received %T",
+			"Expected sc.Right() to be the symbolic.K 0; received %v (%T)",
+			sc.Right(),
 			sc.Right(),
 		)
 	}
@@ -251,6 +254,87 @@ func TestScalarConstraint_Simplify2(t *testing.T) {
 	}
 }
 
+/*
+TestScalarConstraint_Triviality1
+Description:
+
+	Tests that the constraint (x + 2 - x) <= 3 is detected as always true,
+	since the variable cancels out and 2 <= 3.
+*/
+func TestScalarConstraint_Triviality1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	sc := symbolic.ScalarConstraint{
+		LeftHandSide:  x.Plus(2.0).Minus(x).(symbolic.ScalarExpression),
+		RightHandSide: symbolic.K(3.0),
+		Sense:         symbolic.SenseLessThanEqual,
+	}
+
+	// Test
+	alwaysTrue, alwaysFalse := sc.Triviality()
+	if !alwaysTrue || alwaysFalse {
+		t.Errorf(
+			"Expected %v to be always true; received (alwaysTrue, alwaysFalse) = (%v, %v)",
+			sc,
+			alwaysTrue,
+			alwaysFalse,
+		)
+	}
+}
+
+/*
+TestScalarConstraint_Triviality2
+Description:
+
+	Tests that the constraint 5 <= 3 is detected as always false.
+*/
+func TestScalarConstraint_Triviality2(t *testing.T) {
+	// Constants
+	sc := symbolic.ScalarConstraint{
+		LeftHandSide:  symbolic.K(5.0),
+		RightHandSide: symbolic.K(3.0),
+		Sense:         symbolic.SenseLessThanEqual,
+	}
+
+	// Test
+	alwaysTrue, alwaysFalse := sc.Triviality()
+	if alwaysTrue || !alwaysFalse {
+		t.Errorf(
+			"Expected %v to be always false; received (alwaysTrue, alwaysFalse) = (%v, %v)",
+			sc,
+			alwaysTrue,
+			alwaysFalse,
+		)
+	}
+}
+
+/*
+TestScalarConstraint_Triviality3
+Description:
+
+	Tests that the constraint x + 1 >= 3 is not detected as trivial.
+*/
+func TestScalarConstraint_Triviality3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	sc := symbolic.ScalarConstraint{
+		LeftHandSide:  x.Plus(1.0).(symbolic.ScalarExpression),
+		RightHandSide: symbolic.K(3.0),
+		Sense:         symbolic.SenseGreaterThanEqual,
+	}
+
+	// Test
+	alwaysTrue, alwaysFalse := sc.Triviality()
+	if alwaysTrue || alwaysFalse {
+		t.Errorf(
+			"Expected %v to not be trivial; received (alwaysTrue, alwaysFalse) = (%v, %v)",
+			sc,
+			alwaysTrue,
+			alwaysFalse,
+		)
+	}
+}
+
 /*
 TestScalarConstraint_Check1
 Description:
@@ -410,3 +494,401 @@ func TestScalarConstraint_Check4(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestScalarConstraint_LinearCoeff1
+Description:
+
+	Verifies that the constraint 2 x + 3 <= y is decomposed into
+	(2, -1)^T (x, y) <= -3.
+*/
+func TestScalarConstraint_LinearCoeff1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	sc := x.Multiply(2.0).Plus(3.0).LessEq(y).(symbolic.ScalarConstraint)
+
+	// Test
+	a := sc.LinearCoeff([]symbolic.Variable{x, y})
+	expected := []float64{2.0, -1.0}
+	for ii, elt := range expected {
+		if a.AtVec(ii) != elt {
+			t.Errorf(
+				"Expected element %v of sc.LinearCoeff() to be %v; received %v",
+				ii, elt, a.AtVec(ii),
+			)
+		}
+	}
+
+	if b := sc.ConstantRHS(); b != -3.0 {
+		t.Errorf(
+			"Expected sc.ConstantRHS() to be -3; received %v",
+			b,
+		)
+	}
+}
+
+/*
+TestScalarConstraint_LinearCoeff2
+Description:
+
+	Verifies that LinearCoeff and ConstantRHS panic for the nonlinear
+	constraint x^2 <= 1.
+*/
+func TestScalarConstraint_LinearCoeff2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	sc := x.Power(2).(symbolic.Monomial).LessEq(1.0).(symbolic.ScalarConstraint)
+
+	// Test
+	for _, method := range []func(){
+		func() { sc.LinearCoeff([]symbolic.Variable{x}) },
+		func() { sc.ConstantRHS() },
+	} {
+		func() {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Errorf(
+						"Expected the nonlinear constraint %v to cause a panic; received nil",
+						sc,
+					)
+				}
+
+				rAsE, ok := r.(error)
+				if !ok || !strings.Contains(rAsE.Error(), "degree 2") {
+					t.Errorf(
+						"Expected a panic about the degree of the constraint; received %v",
+						r,
+					)
+				}
+			}()
+
+			method()
+		}()
+	}
+}
+
+/*
+TestScalarConstraint_Flip1
+Description:
+
+	Verifies that x >= 3 flips to 3 <= x and that both constraints are
+	satisfied by exactly the same values of x.
+*/
+func TestScalarConstraint_Flip1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	sc := x.GreaterEq(3.0).(symbolic.ScalarConstraint)
+
+	isSatisfied := func(c symbolic.ScalarConstraint, value float64) bool {
+		assignment := map[symbolic.Variable]float64{x: value}
+		left, _ := c.LeftHandSide.Eval(assignment)
+		right, _ := c.RightHandSide.Eval(assignment)
+		switch c.Sense {
+		case symbolic.SenseLessThanEqual:
+			return left <= right
+		case symbolic.SenseGreaterThanEqual:
+			return left >= right
+		default:
+			return left == right
+		}
+	}
+
+	// Test
+	flipped := sc.Flip()
+	if flipped.Sense != symbolic.SenseLessThanEqual {
+		t.Errorf(
+			"Expected the flipped constraint to have sense <=; received %v",
+			flipped.Sense,
+		)
+	}
+
+	if !symbolic.AreEqual(flipped.LeftHandSide, symbolic.K(3.0), 1e-12) ||
+		!symbolic.AreEqual(flipped.RightHandSide, x, 1e-12) {
+		t.Errorf(
+			"Expected the flipped constraint to be 3 <= x; received %v %v %v",
+			flipped.LeftHandSide, flipped.Sense, flipped.RightHandSide,
+		)
+	}
+
+	for _, value := range []float64{-1.0, 2.9, 3.0, 3.1, 10.0} {
+		if isSatisfied(sc, value) != isSatisfied(flipped, value) {
+			t.Errorf(
+				"Expected sc and sc.Flip() to agree at x = %v",
+				value,
+			)
+		}
+	}
+}
+
+/*
+TestScalarConstraint_Flip2
+Description:
+
+	Verifies that flipping an equality constraint keeps it an equality.
+*/
+func TestScalarConstraint_Flip2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	sc := x.Eq(1.0).(symbolic.ScalarConstraint)
+
+	// Test
+	if flipped := sc.Flip(); flipped.Sense != symbolic.SenseEqual {
+		t.Errorf(
+			"Expected the flipped constraint to have sense =; received %v",
+			flipped.Sense,
+		)
+	}
+}
+
+/*
+TestScalarConstraint_AsLessEq1
+Description:
+
+	Verifies that 2 x + 1 >= y + 4 is rewritten as -2 x + y <= -3.
+*/
+func TestScalarConstraint_AsLessEq1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	sc := x.Multiply(2.0).Plus(1.0).GreaterEq(y.Plus(4.0)).(symbolic.ScalarConstraint)
+
+	// Test
+	rewritten := sc.AsLessEq()
+	if rewritten.Sense != symbolic.SenseLessThanEqual {
+		t.Errorf(
+			"Expected the rewritten constraint to have sense <=; received %v",
+			rewritten.Sense,
+		)
+	}
+
+	expectedLeft := x.Multiply(-2.0).Plus(y).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(rewritten.LeftHandSide, expectedLeft, 1e-12) {
+		t.Errorf(
+			"Expected the left hand side to be %v; received %v",
+			expectedLeft, rewritten.LeftHandSide,
+		)
+	}
+
+	if !symbolic.AreEqual(rewritten.RightHandSide, symbolic.K(-3.0), 1e-12) {
+		t.Errorf(
+			"Expected the right hand side to be -3; received %v",
+			rewritten.RightHandSide,
+		)
+	}
+}
+
+/*
+TestScalarConstraint_Simplify3
+Description:
+
+	This function tests that the Simplify() method of the constraint
+	x + 1 <= y + 2 gives x - y - 1 <= 0.
+*/
+func TestScalarConstraint_Simplify3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	sc := x.Plus(1.0).LessEq(y.Plus(2.0)).(symbolic.ScalarConstraint)
+
+	// Simplify
+	sc = sc.Simplify()
+
+	// Verify the form of the constraint
+	expectedLeft := x.Minus(y).Minus(1.0).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(sc.LeftHandSide, expectedLeft, 1e-12) {
+		t.Errorf(
+			"Expected sc.Left() to be %v; received %v",
+			expectedLeft,
+			sc.Left(),
+		)
+	}
+
+	leftAsP, ok := sc.Left().(symbolic.Polynomial)
+	if !ok {
+		t.Errorf(
+			"Expected sc.Left() to be a symbolic.Polynomial; received %T",
+			sc.Left(),
+		)
+	}
+
+	if len(leftAsP.Monomials) != 3 {
+		t.Errorf(
+			"Expected sc.Left() to have 3 monomials after simplification; received %v",
+			len(leftAsP.Monomials),
+		)
+	}
+
+	if sc.Sense != symbolic.SenseLessThanEqual {
+		t.Errorf(
+			"Expected sc.Sense to be <=; received %v",
+			sc.Sense,
+		)
+	}
+
+	if rightAsK, ok := sc.Right().(symbolic.K); !ok || float64(rightAsK) != 0 {
+		t.Errorf(
+			"Expected sc.Right() to be the symbolic.K 0; received %v (%T)",
+			sc.Right(),
+			sc.Right(),
+		)
+	}
+}
+
+/*
+TestScalarConstraint_IsSatisfiedAt1
+Description:
+
+	Verifies that the constraint x + 1 <= 5 is satisfied at x = 2 and
+	violated at x = 10.
+*/
+func TestScalarConstraint_IsSatisfiedAt1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	sc := x.Plus(1.0).LessEq(5.0).(symbolic.ScalarConstraint)
+
+	// Test
+	satisfied, err := sc.IsSatisfiedAt(map[symbolic.Variable]float64{x: 2.0})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if !satisfied {
+		t.Errorf("Expected %v to be satisfied at x = 2; it was not", sc)
+	}
+
+	satisfied, err = sc.IsSatisfiedAt(map[symbolic.Variable]float64{x: 10.0})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if satisfied {
+		t.Errorf("Expected %v to be violated at x = 10; it was not", sc)
+	}
+}
+
+/*
+TestScalarConstraint_IsSatisfiedAt2
+Description:
+
+	Verifies that IsSatisfiedAt returns an error when a variable of the
+	constraint is not assigned a value.
+*/
+func TestScalarConstraint_IsSatisfiedAt2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	sc := x.Plus(y).LessEq(5.0).(symbolic.ScalarConstraint)
+
+	// Test
+	_, err := sc.IsSatisfiedAt(map[symbolic.Variable]float64{x: 2.0})
+	if err == nil {
+		t.Errorf("Expected an error when y is unassigned; received nil")
+	}
+}
+
+/*
+TestScalarConstraint_IsSatisfiedAt3
+Description:
+
+	Verifies that the tolerance is used when checking the equality
+	constraint 3 x == 1 at x = 0.3333.
+*/
+func TestScalarConstraint_IsSatisfiedAt3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	sc := x.Multiply(3.0).Eq(1.0).(symbolic.ScalarConstraint)
+	assignment := map[symbolic.Variable]float64{x: 0.3333}
+
+	// Test
+	satisfied, err := sc.IsSatisfiedAt(assignment)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if satisfied {
+		t.Errorf("Expected %v to be violated at x = 0.3333 without a tolerance; it was not", sc)
+	}
+
+	satisfied, err = sc.IsSatisfiedAt(assignment, 1e-3)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if !satisfied {
+		t.Errorf("Expected %v to be satisfied at x = 0.3333 with a tolerance of 1e-3; it was not", sc)
+	}
+}
+
+/*
+TestScalarConstraint_IsSatisfiedAt4
+Description:
+
+	Verifies that the strict constraint x < 5 is violated at x = 5, while the
+	non-strict constraint x <= 5 is satisfied there.
+*/
+func TestScalarConstraint_IsSatisfiedAt4(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	strict := x.Comparison(5.0, symbolic.SenseLessThan).(symbolic.ScalarConstraint)
+	nonStrict := x.LessEq(5.0).(symbolic.ScalarConstraint)
+	assignment := map[symbolic.Variable]float64{x: 5.0}
+
+	// Test
+	satisfied, err := strict.IsSatisfiedAt(assignment)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if satisfied {
+		t.Errorf("Expected %v to be violated at x = 5; it was not", strict)
+	}
+
+	satisfied, err = nonStrict.IsSatisfiedAt(assignment)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if !satisfied {
+		t.Errorf("Expected %v to be satisfied at x = 5; it was not", nonStrict)
+	}
+}
+
+/*
+TestScalarConstraint_IsSatisfiedAt5
+Description:
+
+	Verifies that a positive tolerance does not loosen strict constraints:
+	x < 5 and x > 5 are both violated at x = 5 even with a tolerance of 1e-3,
+	while x <= 5 is satisfied at x = 5.0005 with that tolerance.
+*/
+func TestScalarConstraint_IsSatisfiedAt5(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	lessThan := x.Comparison(5.0, symbolic.SenseLessThan).(symbolic.ScalarConstraint)
+	greaterThan := x.Comparison(5.0, symbolic.SenseGreaterThan).(symbolic.ScalarConstraint)
+	nonStrict := x.LessEq(5.0).(symbolic.ScalarConstraint)
+	tol := 1e-3
+
+	// Test
+	for _, sc := range []symbolic.ScalarConstraint{lessThan, greaterThan} {
+		satisfied, err := sc.IsSatisfiedAt(map[symbolic.Variable]float64{x: 5.0}, tol)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if satisfied {
+			t.Errorf("Expected %v to be violated at x = 5 with a tolerance of %v; it was not", sc, tol)
+		}
+	}
+
+	satisfied, err := nonStrict.IsSatisfiedAt(map[symbolic.Variable]float64{x: 5.0005}, tol)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if !satisfied {
+		t.Errorf("Expected %v to be satisfied at x = 5.0005 with a tolerance of %v; it was not", nonStrict, tol)
+	}
+}
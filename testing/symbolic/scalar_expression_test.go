@@ -226,3 +226,336 @@ func TestScalarExpression_ScalarPowerTemplate2(t *testing.T) {
 	// Call Function
 	symbolic.ScalarPowerTemplate(x, testExponent)
 }
+
+/*
+TestScalarExpression_AreEqual1
+Description:
+
+	Tests that AreEqual returns true for x * y and y * x,
+	and false for x * y and x.
+*/
+func TestScalarExpression_AreEqual1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	xy := x.Multiply(y).(symbolic.ScalarExpression)
+	yx := y.Multiply(x).(symbolic.ScalarExpression)
+
+	// Test
+	if !symbolic.AreEqual(xy, yx, 1e-9) {
+		t.Errorf("Expected %v and %v to be equal", xy, yx)
+	}
+
+	if symbolic.AreEqual(xy, x, 1e-9) {
+		t.Errorf("Expected %v and %v to not be equal", xy, x)
+	}
+}
+
+/*
+TestScalarExpression_AreEqual2
+Description:
+
+	Tests that AreEqual respects the tolerance when comparing constants
+	to polynomials with a constant part.
+*/
+func TestScalarExpression_AreEqual2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p1 := x.Plus(1.0).(symbolic.ScalarExpression)
+	p2 := x.Plus(1.0 + 1e-6).(symbolic.ScalarExpression)
+
+	// Test
+	if !symbolic.AreEqual(p1, p2, 1e-3) {
+		t.Errorf("Expected %v and %v to be equal with tolerance 1e-3", p1, p2)
+	}
+
+	if symbolic.AreEqual(p1, p2, 1e-9) {
+		t.Errorf("Expected %v and %v to not be equal with tolerance 1e-9", p1, p2)
+	}
+
+	if !symbolic.AreEqual(symbolic.K(2.0), symbolic.K(2.0).ToPolynomial(), 0.0) {
+		t.Errorf("Expected K(2.0) and its polynomial form to be equal")
+	}
+}
+
+/*
+TestScalarExpression_Laplacian1
+Description:
+
+	Tests that the Laplacian of x^2 + y^2 with respect to [x, y] is the constant 4.
+*/
+func TestScalarExpression_Laplacian1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Power(2).Plus(y.Power(2)).(symbolic.ScalarExpression)
+
+	// Test
+	lap := symbolic.Laplacian(f, []symbolic.Variable{x, y})
+	lapAsK, tf := lap.(symbolic.K)
+	if !tf {
+		t.Errorf("Expected the Laplacian to be a K; received %T (%v)", lap, lap)
+	}
+
+	if float64(lapAsK) != 4.0 {
+		t.Errorf("Expected the Laplacian to be 4; received %v", lap)
+	}
+
+	// Verify that the input was not modified
+	if f.(symbolic.Polynomial).Degree() != 2 {
+		t.Errorf("Expected %v to still have degree 2", f)
+	}
+}
+
+/*
+TestScalarExpression_Laplacian2
+Description:
+
+	Tests that the Laplacian of x^3 y with respect to [x, y] is 6 x y and
+	that the mixed partials are not included.
+*/
+func TestScalarExpression_Laplacian2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Power(3).Multiply(y).(symbolic.ScalarExpression)
+
+	// Test
+	lap := symbolic.Laplacian(f, []symbolic.Variable{x, y})
+	expected := x.Multiply(y).Multiply(6.0).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(lap, expected, 1e-9) {
+		t.Errorf("Expected the Laplacian to be %v; received %v", expected, lap)
+	}
+}
+
+/*
+TestScalarExpression_Gradient1
+Description:
+
+	Tests that the Gradient of the quadratic polynomial
+		x^2 + 3 x y + 2 y + 1
+	with respect to (x, y) is the affine vector (2x + 3y, 3x + 2).
+*/
+func TestScalarExpression_Gradient1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Power(2).Plus(
+		x.Multiply(y).Multiply(3.0),
+	).Plus(
+		y.Multiply(2.0),
+	).Plus(1.0).(symbolic.ScalarExpression)
+
+	// Test
+	grad := symbolic.Gradient(f, []symbolic.Variable{x, y})
+	if grad.Len() != 2 {
+		t.Errorf("Expected gradient to have length 2; received %v", grad.Len())
+	}
+
+	expected := []symbolic.ScalarExpression{
+		x.Multiply(2.0).Plus(y.Multiply(3.0)).(symbolic.ScalarExpression),
+		x.Multiply(3.0).Plus(2.0).(symbolic.ScalarExpression),
+	}
+	for ii, e := range expected {
+		if !symbolic.AreEqual(grad.AtVec(ii), e, 1e-10) {
+			t.Errorf("Expected gradient entry %v to be %v; received %v", ii, e, grad.AtVec(ii))
+		}
+
+		if !symbolic.IsLinear(grad.AtVec(ii)) {
+			t.Errorf("Expected gradient entry %v to be affine; received %v", ii, grad.AtVec(ii))
+		}
+	}
+}
+
+/*
+TestScalarExpression_Gradient2
+Description:
+
+	Tests that the Gradient of a constant is a zero KVector and that
+	an empty list of variables causes a panic.
+*/
+func TestScalarExpression_Gradient2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+
+	// Test
+	grad := symbolic.Gradient(symbolic.K(3.0), []symbolic.Variable{x, y})
+	gradAsKV, ok := grad.(symbolic.KVector)
+	if !ok {
+		t.Errorf("Expected gradient to be a KVector; received %T", grad)
+	}
+
+	for ii := 0; ii < gradAsKV.Len(); ii++ {
+		if float64(gradAsKV[ii]) != 0.0 {
+			t.Errorf("Expected gradient entry %v to be 0; received %v", ii, gradAsKV[ii])
+		}
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected Gradient to panic; received nil")
+		}
+	}()
+
+	symbolic.Gradient(x.ToPolynomial(), []symbolic.Variable{})
+}
+
+/*
+TestScalarExpression_Hessian1
+Description:
+
+	Tests that the Hessian of the quadratic polynomial
+		x^2 + 3 x y + 2 y^2 + y
+	with respect to (x, y) is the symmetric KMatrix [[2, 3], [3, 4]].
+*/
+func TestScalarExpression_Hessian1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Power(2).Plus(
+		x.Multiply(y).Multiply(3.0),
+	).Plus(
+		y.Power(2).Multiply(2.0),
+	).Plus(y).(symbolic.ScalarExpression)
+
+	// Test
+	H := symbolic.Hessian(f, []symbolic.Variable{x, y})
+	HAsKM, ok := H.(symbolic.KMatrix)
+	if !ok {
+		t.Errorf("Expected Hessian to be a KMatrix; received %T", H)
+	}
+
+	expected := [][]float64{{2.0, 3.0}, {3.0, 4.0}}
+	for ii, row := range expected {
+		for jj, hIJ := range row {
+			if float64(HAsKM[ii][jj]) != hIJ {
+				t.Errorf("Expected H[%v][%v] to be %v; received %v", ii, jj, hIJ, HAsKM[ii][jj])
+			}
+		}
+	}
+}
+
+/*
+TestScalarExpression_Hessian2
+Description:
+
+	Tests that the Hessian of a cubic polynomial x^2 y is the symmetric
+	PolynomialMatrix [[2y, 2x], [2x, 0]] and that the Hessian of a linear
+	expression is an all-zeros matrix.
+*/
+func TestScalarExpression_Hessian2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	wrt := []symbolic.Variable{x, y}
+	f := x.Power(2).Multiply(y).(symbolic.ScalarExpression)
+
+	// Test
+	H := symbolic.Hessian(f, wrt)
+	if _, ok := H.(symbolic.PolynomialMatrix); !ok {
+		t.Errorf("Expected Hessian to be a PolynomialMatrix; received %T", H)
+	}
+
+	expected := [][]symbolic.ScalarExpression{
+		{y.Multiply(2.0).(symbolic.ScalarExpression), x.Multiply(2.0).(symbolic.ScalarExpression)},
+		{x.Multiply(2.0).(symbolic.ScalarExpression), symbolic.K(0.0)},
+	}
+	for ii, row := range expected {
+		for jj, hIJ := range row {
+			if !symbolic.AreEqual(H.At(ii, jj), hIJ, 1e-10) {
+				t.Errorf("Expected H[%v][%v] to be %v; received %v", ii, jj, hIJ, H.At(ii, jj))
+			}
+		}
+	}
+
+	// Linear expression
+	HLinear := symbolic.Hessian(x.Plus(y.Multiply(5.0)).(symbolic.ScalarExpression), wrt)
+	if HLinear.Dims()[0] != 2 || HLinear.Dims()[1] != 2 {
+		t.Errorf("Expected Hessian to have dimensions (2, 2); received %v", HLinear.Dims())
+	}
+
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 2; jj++ {
+			if !symbolic.AreEqual(HLinear.At(ii, jj), symbolic.K(0.0), 1e-10) {
+				t.Errorf("Expected H[%v][%v] to be 0; received %v", ii, jj, HLinear.At(ii, jj))
+			}
+		}
+	}
+}
+
+/*
+TestScalarExpression_Hessian3
+Description:
+
+	Tests that the Hessian panics when wrt is empty.
+*/
+func TestScalarExpression_Hessian3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected Hessian to panic; received nil")
+		}
+	}()
+
+	symbolic.Hessian(x.ToPolynomial(), []symbolic.Variable{})
+}
+
+/*
+TestScalarExpression_QuadraticApproximation1
+Description:
+
+	Tests that the QuadraticApproximation of x^3 around x0 = 1 is
+		1 + 3 (x - 1) + 3 (x - 1)^2 = 3 x^2 - 3 x + 1.
+*/
+func TestScalarExpression_QuadraticApproximation1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	f := x.Power(3).(symbolic.ScalarExpression)
+
+	// Test
+	approx, err := symbolic.QuadraticApproximation(f, map[symbolic.Variable]float64{x: 1.0})
+	if err != nil {
+		t.Errorf("Expected QuadraticApproximation to succeed; received error %v", err)
+	}
+
+	expected := x.Power(2).Multiply(3.0).Plus(x.Multiply(-3.0)).Plus(1.0).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(approx, expected, 1e-10) {
+		t.Errorf("Expected approximation to be %v; received %v", expected, approx)
+	}
+}
+
+/*
+TestScalarExpression_QuadraticApproximation2
+Description:
+
+	Tests that the QuadraticApproximation of a quadratic polynomial in two
+	variables reproduces the polynomial exactly and that a missing variable
+	in the point causes an error.
+*/
+func TestScalarExpression_QuadraticApproximation2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Power(2).Plus(x.Multiply(y).Multiply(3.0)).Plus(y.Multiply(-2.0)).Plus(4.0).(symbolic.ScalarExpression)
+
+	// Test
+	approx, err := symbolic.QuadraticApproximation(f, map[symbolic.Variable]float64{x: 2.0, y: -1.0})
+	if err != nil {
+		t.Errorf("Expected QuadraticApproximation to succeed; received error %v", err)
+	}
+
+	if !symbolic.AreEqual(approx, f, 1e-10) {
+		t.Errorf("Expected approximation to be %v; received %v", f, approx)
+	}
+
+	_, err = symbolic.QuadraticApproximation(f, map[symbolic.Variable]float64{x: 2.0})
+	if err == nil {
+		t.Errorf("Expected QuadraticApproximation to return an error; received nil")
+	}
+}
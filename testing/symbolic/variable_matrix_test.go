@@ -429,6 +429,155 @@ func TestVariableMatrix_Plus6(t *testing.T) {
 	vm.Plus("hello")
 }
 
+/*
+TestVariableMatrix_Plus7
+Description:
+
+	Tests the Plus method for a VariableMatrix object that is well-defined
+	being added to itself. Checks that the result is a PolynomialMatrix
+	where each entry contains a single monomial (i.e., 2 * x_ij).
+*/
+func TestVariableMatrix_Plus7(t *testing.T) {
+	// Constants
+	vm := symbolic.VariableMatrix{
+		{symbolic.NewVariable(), symbolic.NewVariable()},
+		{symbolic.NewVariable(), symbolic.NewVariable()},
+	}
+
+	// Test
+	result := vm.Plus(vm)
+
+	pm, ok := result.(symbolic.PolynomialMatrix)
+	if !ok {
+		t.Errorf("Expected Plus to return a PolynomialMatrix; received %T", result)
+	}
+
+	for ii := 0; ii < pm.Dims()[0]; ii++ {
+		for jj := 0; jj < pm.Dims()[1]; jj++ {
+			if len(pm[ii][jj].Monomials) != 1 {
+				t.Errorf(
+					"Expected entry (%v,%v) to contain 1 monomial; received %v",
+					ii, jj, len(pm[ii][jj].Monomials),
+				)
+			}
+
+			if pm[ii][jj].Monomials[0].Coefficient != 2.0 {
+				t.Errorf(
+					"Expected entry (%v,%v) to have coefficient 2; received %v",
+					ii, jj, pm[ii][jj].Monomials[0].Coefficient,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestVariableMatrix_Plus8
+Description:
+
+	Tests the Plus method for a VariableMatrix object that is well-defined
+	being added to a scalar Variable, Monomial, and Polynomial.
+	Checks that each result is a PolynomialMatrix whose entries contain
+	two monomials.
+*/
+func TestVariableMatrix_Plus8(t *testing.T) {
+	// Constants
+	vm := symbolic.VariableMatrix{
+		{symbolic.NewVariable(), symbolic.NewVariable()},
+		{symbolic.NewVariable(), symbolic.NewVariable()},
+	}
+	y := symbolic.NewVariable()
+	m := symbolic.Monomial{
+		Coefficient:     3.0,
+		VariableFactors: []symbolic.Variable{y},
+		Exponents:       []int{2},
+	}
+	p := y.Plus(1.0).(symbolic.Polynomial)
+
+	// Test
+	for _, right := range []interface{}{y, m} {
+		result := vm.Plus(right)
+
+		pm, ok := result.(symbolic.PolynomialMatrix)
+		if !ok {
+			t.Errorf("Expected Plus to return a PolynomialMatrix; received %T", result)
+			continue
+		}
+
+		for ii := 0; ii < pm.Dims()[0]; ii++ {
+			for jj := 0; jj < pm.Dims()[1]; jj++ {
+				if len(pm[ii][jj].Monomials) != 2 {
+					t.Errorf(
+						"Expected entry (%v,%v) to contain 2 monomials; received %v",
+						ii, jj, len(pm[ii][jj].Monomials),
+					)
+				}
+			}
+		}
+	}
+
+	pm, ok := vm.Plus(p).(symbolic.PolynomialMatrix)
+	if !ok {
+		t.Errorf("Expected Plus to return a PolynomialMatrix; received %T", vm.Plus(p))
+	}
+
+	for ii := 0; ii < pm.Dims()[0]; ii++ {
+		for jj := 0; jj < pm.Dims()[1]; jj++ {
+			if len(pm[ii][jj].Monomials) != 3 {
+				t.Errorf(
+					"Expected entry (%v,%v) to contain 3 monomials; received %v",
+					ii, jj, len(pm[ii][jj].Monomials),
+				)
+			}
+		}
+	}
+}
+
+/*
+TestVariableMatrix_Plus9
+Description:
+
+	Tests the Plus method for a VariableMatrix object that is well-defined
+	being added to a MonomialMatrix and a PolynomialMatrix of the same
+	shape. Checks that each entry of the result contains two monomials.
+*/
+func TestVariableMatrix_Plus9(t *testing.T) {
+	// Constants
+	vm := symbolic.VariableMatrix{
+		{symbolic.NewVariable(), symbolic.NewVariable()},
+		{symbolic.NewVariable(), symbolic.NewVariable()},
+	}
+	vm2 := symbolic.VariableMatrix{
+		{symbolic.NewVariable(), symbolic.NewVariable()},
+		{symbolic.NewVariable(), symbolic.NewVariable()},
+	}
+
+	// Test
+	for _, right := range []symbolic.Expression{
+		vm2.ToMonomialMatrix(),
+		vm2.ToPolynomialMatrix(),
+	} {
+		result := vm.Plus(right)
+
+		pm, ok := result.(symbolic.PolynomialMatrix)
+		if !ok {
+			t.Errorf("Expected Plus to return a PolynomialMatrix; received %T", result)
+			continue
+		}
+
+		for ii := 0; ii < pm.Dims()[0]; ii++ {
+			for jj := 0; jj < pm.Dims()[1]; jj++ {
+				if len(pm[ii][jj].Monomials) != 2 {
+					t.Errorf(
+						"Expected entry (%v,%v) to contain 2 monomials; received %v",
+						ii, jj, len(pm[ii][jj].Monomials),
+					)
+				}
+			}
+		}
+	}
+}
+
 /*
 TestVariableMatrix_Minus1
 Description:
@@ -1087,6 +1236,164 @@ func TestVariableMatrix_Multiply15(t *testing.T) {
 	}
 }
 
+/*
+TestVariableMatrix_Multiply16
+Description:
+
+	Tests the Multiply method for a VariableMatrix of dimension (2, 3) being
+	multiplied by another VariableMatrix of dimension (3, 2).
+	The product should be a (2, 2) PolynomialMatrix with three monomials
+	in each polynomial.
+*/
+func TestVariableMatrix_Multiply16(t *testing.T) {
+	// Constants
+	vm1 := symbolic.NewVariableMatrix(2, 3)
+	vm2 := symbolic.NewVariableMatrix(3, 2)
+
+	// Compute Product
+	result := vm1.Multiply(vm2)
+
+	// Check that object is a PolynomialMatrix
+	pm, ok := result.(symbolic.PolynomialMatrix)
+	if !ok {
+		t.Errorf("Expected Multiply to return a PolynomialMatrix; received %T", result)
+	}
+
+	if pm.Dims()[0] != 2 || pm.Dims()[1] != 2 {
+		t.Errorf("Expected product to have dimensions (2, 2); received %v", pm.Dims())
+	}
+
+	// Check that each polynomial in the result contains three monomials.
+	for ii := 0; ii < pm.Dims()[0]; ii++ {
+		for jj := 0; jj < pm.Dims()[1]; jj++ {
+			if len(pm[ii][jj].Monomials) != 3 {
+				t.Errorf(
+					"Expected entry (%v,%v) to contain 3 monomials; received %v",
+					ii, jj, len(pm[ii][jj].Monomials),
+				)
+			}
+
+			if pm[ii][jj].Degree() != 2 {
+				t.Errorf(
+					"Expected entry (%v,%v) to have degree 2; received %v",
+					ii, jj, pm[ii][jj].Degree(),
+				)
+			}
+		}
+	}
+}
+
+/*
+TestVariableMatrix_Multiply17
+Description:
+
+	Tests the Multiply method for a VariableMatrix of dimension (2, 3) being
+	multiplied by a MonomialMatrix of dimension (3, 2).
+	The product should be a (2, 2) PolynomialMatrix.
+*/
+func TestVariableMatrix_Multiply17(t *testing.T) {
+	// Constants
+	vm1 := symbolic.NewVariableMatrix(2, 3)
+	mm2 := symbolic.NewVariableMatrix(3, 2).ToMonomialMatrix()
+
+	// Compute Product
+	result := vm1.Multiply(mm2)
+
+	// Check that object is a PolynomialMatrix
+	pm, ok := result.(symbolic.PolynomialMatrix)
+	if !ok {
+		t.Errorf("Expected Multiply to return a PolynomialMatrix; received %T", result)
+	}
+
+	if pm.Dims()[0] != 2 || pm.Dims()[1] != 2 {
+		t.Errorf("Expected product to have dimensions (2, 2); received %v", pm.Dims())
+	}
+}
+
+/*
+TestVariableMatrix_Multiply18
+Description:
+
+	Tests the Multiply method for a VariableMatrix of dimension (2, 3) being
+	multiplied by a VariableMatrix of dimension (2, 3).
+	The dimensions do not match, so the method should panic.
+*/
+func TestVariableMatrix_Multiply18(t *testing.T) {
+	// Constants
+	vm1 := symbolic.NewVariableMatrix(2, 3)
+	vm2 := symbolic.NewVariableMatrix(2, 3)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected Multiply to panic; received nil")
+		}
+
+		rAsE, ok := r.(error)
+		if !ok {
+			t.Errorf("Expected Multiply to panic with an error; received %v", r)
+		}
+
+		if _, ok := rAsE.(smErrors.DimensionError); !ok {
+			t.Errorf("Expected Multiply to panic with a DimensionError; received %T", rAsE)
+		}
+	}()
+
+	vm1.Multiply(vm2)
+}
+
+/*
+TestVariableMatrix_AsColumn1
+Description:
+
+	Verifies that the AsColumn method returns an error when the
+	VariableMatrix has more than one column.
+*/
+func TestVariableMatrix_AsColumn1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(3, 2)
+
+	// Test
+	_, err := vm.AsColumn()
+	if err == nil {
+		t.Errorf("Expected AsColumn to return an error; received nil")
+	}
+}
+
+/*
+TestVariableMatrix_AsColumn2
+Description:
+
+	Verifies that the AsColumn method returns a VariableVector containing
+	the entries of an N x 1 VariableMatrix (in order).
+*/
+func TestVariableMatrix_AsColumn2(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(4, 1)
+
+	// Test
+	vv, err := vm.AsColumn()
+	if err != nil {
+		t.Errorf("Expected AsColumn to succeed; received error %v", err)
+	}
+
+	if vv.Len() != 4 {
+		t.Errorf("Expected vector of length 4; received %v", vv.Len())
+	}
+
+	for ii := 0; ii < 4; ii++ {
+		if vv[ii].ID != vm[ii][0].ID {
+			t.Errorf(
+				"Expected element %v to be %v; received %v",
+				ii,
+				vm[ii][0],
+				vv[ii],
+			)
+		}
+	}
+}
+
 /*
 TestVariableMatrix_Transpose1
 Description:
@@ -1305,3 +1612,216 @@ func TestVariableMatrix_Eq2(t *testing.T) {
 		t.Errorf("Expected Eq to return a MatrixConstraint; received %T", mc0)
 	}
 }
+
+/*
+TestVariableMatrix_ElementWisePower1
+Description:
+
+	Verifies that cubing a 2 x 3 VariableMatrix element-wise gives a
+	MonomialMatrix of the same shape where each element has exponent 3.
+*/
+func TestVariableMatrix_ElementWisePower1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(2, 3)
+
+	// Test
+	r := vm.ElementWisePower(3)
+	rAsMM, ok := r.(symbolic.MonomialMatrix)
+	if !ok {
+		t.Errorf(
+			"Expected vm.ElementWisePower(3) to return a MonomialMatrix object; received %T",
+			r,
+		)
+	}
+
+	if rAsMM.Dims()[0] != 2 || rAsMM.Dims()[1] != 3 {
+		t.Errorf(
+			"Expected r to have dimensions 2 x 3; received %v",
+			rAsMM.Dims(),
+		)
+	}
+
+	for ii, row := range rAsMM {
+		for jj, monomial := range row {
+			if monomial.VariableFactors[0].ID != vm[ii][jj].ID || monomial.Exponents[0] != 3 {
+				t.Errorf(
+					"Expected element (%v,%v) of r to be %v^3; received %v",
+					ii, jj, vm[ii][jj], monomial,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestVariableMatrix_ElementWisePower2
+Description:
+
+	Verifies that raising a VariableMatrix to the power 0 element-wise gives
+	a KMatrix of ones with the same shape.
+*/
+func TestVariableMatrix_ElementWisePower2(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(3, 2)
+
+	// Test
+	r := vm.ElementWisePower(0)
+	rAsKM, ok := r.(symbolic.KMatrix)
+	if !ok {
+		t.Errorf(
+			"Expected vm.ElementWisePower(0) to return a KMatrix object; received %T",
+			r,
+		)
+	}
+
+	if rAsKM.Dims()[0] != 3 || rAsKM.Dims()[1] != 2 {
+		t.Errorf(
+			"Expected r to have dimensions 3 x 2; received %v",
+			rAsKM.Dims(),
+		)
+	}
+
+	for ii, row := range rAsKM {
+		for jj, element := range row {
+			if float64(element) != 1.0 {
+				t.Errorf(
+					"Expected element (%v,%v) of r to be 1; received %v",
+					ii, jj, element,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestVariableMatrix_SubMatrix1
+Description:
+
+	Verifies that SubMatrix(2, 4, 0, 2) of a 4 x 4 VariableMatrix is the
+	VariableMatrix formed by the bottom-left 2 x 2 block.
+*/
+func TestVariableMatrix_SubMatrix1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(4, 4)
+
+	// Test
+	block := vm.SubMatrix(2, 4, 0, 2)
+	blockAsVM, ok := block.(symbolic.VariableMatrix)
+	if !ok {
+		t.Errorf(
+			"Expected vm.SubMatrix(2, 4, 0, 2) to return a VariableMatrix; received %T",
+			block,
+		)
+	}
+
+	for ii, row := range blockAsVM {
+		for jj, v := range row {
+			if v.ID != vm[2+ii][jj].ID {
+				t.Errorf(
+					"Expected element (%v,%v) of the block to be %v; received %v",
+					ii, jj, vm[2+ii][jj], v,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestVariableMatrix_Column1
+Description:
+
+	Verifies that Column(1) of a 3 x 2 VariableMatrix is a VariableVector of
+	length 3 containing the variables of the second column.
+*/
+func TestVariableMatrix_Column1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(3, 2)
+
+	// Test
+	column := vm.Column(1)
+	columnAsVV, ok := column.(symbolic.VariableVector)
+	if !ok {
+		t.Errorf(
+			"Expected vm.Column(1) to return a VariableVector; received %T",
+			column,
+		)
+	}
+
+	if columnAsVV.Len() != 3 {
+		t.Errorf(
+			"Expected vm.Column(1) to have length 3; received %v",
+			columnAsVV.Len(),
+		)
+	}
+
+	for ii, v := range columnAsVV {
+		if v.ID != vm[ii][1].ID {
+			t.Errorf(
+				"Expected element %v of vm.Column(1) to be %v; received %v",
+				ii, vm[ii][1], v,
+			)
+		}
+	}
+}
+
+/*
+TestVariableMatrix_Row1
+Description:
+
+	Verifies that Row(2) of a 3 x 2 VariableMatrix is a VariableVector of
+	length 2 containing the variables of the last row.
+*/
+func TestVariableMatrix_Row1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(3, 2)
+
+	// Test
+	row := vm.Row(2)
+	rowAsVV, ok := row.(symbolic.VariableVector)
+	if !ok {
+		t.Errorf(
+			"Expected vm.Row(2) to return a VariableVector; received %T",
+			row,
+		)
+	}
+
+	for jj, v := range rowAsVV {
+		if v.ID != vm[2][jj].ID {
+			t.Errorf(
+				"Expected element %v of vm.Row(2) to be %v; received %v",
+				jj, vm[2][jj], v,
+			)
+		}
+	}
+}
+
+/*
+TestVariableMatrix_Row2
+Description:
+
+	Verifies that Row panics with an InvalidMatrixIndexError when the row index
+	is out of bounds.
+*/
+func TestVariableMatrix_Row2(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(3, 2)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected vm.Row(3) to panic; received nil",
+			)
+		}
+
+		if _, ok := r.(smErrors.InvalidMatrixIndexError); !ok {
+			t.Errorf(
+				"Expected vm.Row(3) to panic with an InvalidMatrixIndexError; received %v",
+				r,
+			)
+		}
+	}()
+
+	vm.Row(3)
+}
@@ -3,7 +3,9 @@ package symbolic_test
 import (
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
 	"gonum.org/v1/gonum/mat"
+	"math"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -1846,3 +1848,343 @@ func TestVariable_Power1(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestVariable_NewNamedVariable1
+Description:
+
+	Verifies that a variable created with NewNamedVariable prints its name
+	and that a variable without a name falls back to x_<ID>.
+*/
+func TestVariable_NewNamedVariable1(t *testing.T) {
+	// Constants
+	speed := symbolic.NewNamedVariable("speed")
+	unnamed := symbolic.Variable{ID: 1234}
+
+	// Test
+	if speed.String() != "speed" {
+		t.Errorf(
+			"Expected speed.String() to be \"speed\"; received %v",
+			speed.String(),
+		)
+	}
+
+	if unnamed.String() != "x_1234" {
+		t.Errorf(
+			"Expected unnamed.String() to be \"x_1234\"; received %v",
+			unnamed.String(),
+		)
+	}
+}
+
+/*
+TestVariable_NewNamedVariable2
+Description:
+
+	Verifies that two variables with the same name are still treated as
+	different variables and that renaming a variable does not change which
+	variable it is (for Variables() deduplication).
+*/
+func TestVariable_NewNamedVariable2(t *testing.T) {
+	// Constants
+	x1 := symbolic.NewNamedVariable("x")
+	x2 := symbolic.NewNamedVariable("x")
+
+	renamedX1 := x1
+	renamedX1.Name = "y"
+
+	// Test
+	if x1.ID == x2.ID {
+		t.Errorf(
+			"Expected two named variables to have different IDs; both have ID %v",
+			x1.ID,
+		)
+	}
+
+	sum := x1.Plus(x2).(symbolic.Polynomial)
+	if len(sum.Variables()) != 2 {
+		t.Errorf(
+			"Expected x1 + x2 to contain 2 variables; received %v",
+			len(sum.Variables()),
+		)
+	}
+
+	unique := symbolic.UniqueVars([]symbolic.Variable{x1, renamedX1, x2})
+	if len(unique) != 2 {
+		t.Errorf(
+			"Expected UniqueVars to treat x1 and its renamed copy as the same variable; received %v",
+			unique,
+		)
+	}
+
+	doubled := x1.Plus(renamedX1).(symbolic.Polynomial)
+	if len(doubled.Monomials) != 1 || doubled.Monomials[0].Coefficient != 2.0 {
+		t.Errorf(
+			"Expected x1 + renamedX1 to be 2 x1; received %v",
+			doubled,
+		)
+	}
+}
+
+/*
+TestVariable_BoundConstraints1
+Description:
+
+	Verifies that a variable created with the bounds [0, 10] produces exactly
+	the two constraints x >= 0 and x <= 10.
+*/
+func TestVariable_BoundConstraints1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable(0.0, 10.0)
+
+	// Test
+	constraints := x.BoundConstraints()
+	if len(constraints) != 2 {
+		t.Errorf(
+			"Expected x.BoundConstraints() to have 2 constraints; received %v",
+			len(constraints),
+		)
+	}
+
+	expected := []struct {
+		sense    symbolic.ConstrSense
+		constant float64
+	}{
+		{sense: symbolic.SenseGreaterThanEqual, constant: 0.0},
+		{sense: symbolic.SenseLessThanEqual, constant: 10.0},
+	}
+	for ii, constraint := range constraints {
+		if constraint.Sense != expected[ii].sense {
+			t.Errorf(
+				"Expected constraint %v to have sense %v; received %v",
+				ii, expected[ii].sense, constraint.Sense,
+			)
+		}
+
+		leftAsV, ok := constraint.LeftHandSide.(symbolic.Variable)
+		if !ok || leftAsV.ID != x.ID {
+			t.Errorf(
+				"Expected the left hand side of constraint %v to be x; received %v",
+				ii, constraint.LeftHandSide,
+			)
+		}
+
+		rightAsK, ok := constraint.RightHandSide.(symbolic.K)
+		if !ok || float64(rightAsK) != expected[ii].constant {
+			t.Errorf(
+				"Expected the right hand side of constraint %v to be %v; received %v",
+				ii, expected[ii].constant, constraint.RightHandSide,
+			)
+		}
+	}
+}
+
+/*
+TestVariable_BoundConstraints2
+Description:
+
+	Verifies that a variable created with NewVariable (which is unbounded)
+	produces no bound constraints and that a variable with only a lower bound
+	produces one.
+*/
+func TestVariable_BoundConstraints2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable(-1.0, math.Inf(1))
+
+	// Test
+	if n := len(x.BoundConstraints()); n != 0 {
+		t.Errorf(
+			"Expected x.BoundConstraints() to be empty; received %v constraints",
+			n,
+		)
+	}
+
+	yConstraints := y.BoundConstraints()
+	if len(yConstraints) != 1 || yConstraints[0].Sense != symbolic.SenseGreaterThanEqual {
+		t.Errorf(
+			"Expected y.BoundConstraints() to be the single constraint y >= -1; received %v",
+			yConstraints,
+		)
+	}
+}
+
+/*
+TestVariable_NewBinaryVariable3
+Description:
+
+	Verifies that NewBinaryVariable creates a well-defined variable of type
+	Binary with the bounds [0, 1].
+*/
+func TestVariable_NewBinaryVariable3(t *testing.T) {
+	// Constants
+	b := symbolic.NewBinaryVariable()
+
+	// Test
+	if b.Type != symbolic.Binary {
+		t.Errorf(
+			"Expected b.Type to be Binary; received %v",
+			b.Type,
+		)
+	}
+
+	if b.Lower != 0.0 || b.Upper != 1.0 {
+		t.Errorf(
+			"Expected b to have bounds [0, 1]; received [%v, %v]",
+			b.Lower, b.Upper,
+		)
+	}
+
+	if err := b.Check(); err != nil {
+		t.Errorf(
+			"Expected b.Check() to return nil; received %v",
+			err,
+		)
+	}
+}
+
+/*
+TestVariable_NewBinaryVariable4
+Description:
+
+	Verifies that Check returns an error for a binary variable whose upper
+	bound is outside of [0, 1].
+*/
+func TestVariable_NewBinaryVariable4(t *testing.T) {
+	// Constants
+	b := symbolic.NewBinaryVariable()
+	b.Upper = 2.0
+
+	// Test
+	err := b.Check()
+	if err == nil {
+		t.Errorf("Expected b.Check() to return an error; received nil")
+	} else if !strings.Contains(err.Error(), "binary variable must have bounds within [0, 1]") {
+		t.Errorf(
+			"Expected b.Check() to return an error about the bounds of a binary variable; received %v",
+			err,
+		)
+	}
+}
+
+/*
+TestVariable_NewVariable1
+Description:
+
+	Tests that variables created concurrently from many goroutines (with
+	NewVariable and NewVariableVector) all receive unique IDs.
+*/
+func TestVariable_NewVariable1(t *testing.T) {
+	// Constants
+	nGoroutines := 50
+	nPerGoroutine := 20
+	env := symbolic.Environment{Name: "test-concurrent-ids"}
+
+	// Create the variables
+	results := make([][]symbolic.Variable, nGoroutines)
+	var wg sync.WaitGroup
+	for ii := 0; ii < nGoroutines; ii++ {
+		wg.Add(1)
+		go func(ii int) {
+			defer wg.Done()
+			for jj := 0; jj < nPerGoroutine/2; jj++ {
+				results[ii] = append(results[ii], symbolic.NewVariable(&env))
+			}
+			results[ii] = append(results[ii], symbolic.NewVariableVector(nPerGoroutine/2, &env)...)
+		}(ii)
+	}
+	wg.Wait()
+
+	// Test
+	ids := make(map[uint64]bool)
+	for _, vars := range results {
+		for _, v := range vars {
+			ids[v.ID] = true
+		}
+	}
+
+	if len(ids) != nGoroutines*nPerGoroutine {
+		t.Errorf(
+			"expected %v unique IDs; received %v",
+			nGoroutines*nPerGoroutine,
+			len(ids),
+		)
+	}
+
+	if len(env.Variables) != nGoroutines*nPerGoroutine {
+		t.Errorf(
+			"expected the environment to contain %v variables; received %v",
+			nGoroutines*nPerGoroutine,
+			len(env.Variables),
+		)
+	}
+}
+
+/*
+TestVariable_NewVariable2
+Description:
+
+	Tests that NewVariable accepts an environment along with a pair of bounds
+	(given as ints) and stores the bounded variable in that environment.
+*/
+func TestVariable_NewVariable2(t *testing.T) {
+	// Constants
+	env := symbolic.Environment{Name: "test-new-variable-bounds"}
+
+	// Test
+	x := symbolic.NewVariable(&env, 0, 10)
+	if x.Lower != 0.0 || x.Upper != 10.0 {
+		t.Errorf("Expected x to have bounds [0, 10]; received [%v, %v]", x.Lower, x.Upper)
+	}
+
+	if len(env.Variables) != 1 || env.Variables[0].Upper != 10.0 {
+		t.Errorf("Expected the environment to contain x with its bounds; received %v", env.Variables)
+	}
+}
+
+/*
+TestVariable_NewVariable3
+Description:
+
+	Tests that NewVariable panics when given only one bound.
+*/
+func TestVariable_NewVariable3(t *testing.T) {
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected NewVariable to panic when given one bound; received nil")
+		}
+	}()
+	symbolic.NewVariable(1.0)
+}
+
+/*
+TestVariable_UniqueVars1
+Description:
+
+	Tests that UniqueVars removes the repeated variables from [z, x, y, x]
+	and returns them sorted by ID, i.e., [x, y, z].
+*/
+func TestVariable_UniqueVars1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	z := symbolic.NewVariable()
+
+	// Test
+	unique := symbolic.UniqueVars([]symbolic.Variable{z, x, y, x})
+	expected := []symbolic.Variable{x, y, z}
+	if len(unique) != len(expected) {
+		t.Fatalf("expected %v unique variables; received %v", len(expected), unique)
+	}
+
+	for ii := range expected {
+		if unique[ii].ID != expected[ii].ID {
+			t.Errorf(
+				"expected unique variable %v to be %v; received %v",
+				ii, expected[ii], unique[ii],
+			)
+		}
+	}
+}
@@ -426,6 +426,231 @@ func TestVariableVector_Plus7(t *testing.T) {
 	vv1.Plus(s2)
 }
 
+/*
+TestVariableVector_Plus8
+Description:
+
+	This test verifies that adding a float64 to a variable vector broadcasts
+	the constant across every element and returns a PolynomialVector whose
+	elements each contain two monomials.
+*/
+func TestVariableVector_Plus8(t *testing.T) {
+	// Constants
+	N := 11
+	vv1 := symbolic.NewVariableVector(N)
+
+	// Test
+	r := vv1.Plus(3.14)
+	pv, ok := r.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv1.Plus(3.14) to return a PolynomialVector object; received %T",
+			r,
+		)
+	}
+
+	for ii, p := range pv {
+		if len(p.Monomials) != 2 || p.Constant() != 3.14 {
+			t.Errorf(
+				"Expected element %v to be %v + 3.14; received %v",
+				ii,
+				vv1[ii],
+				p,
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Plus9
+Description:
+
+	This test verifies that adding a K to a variable vector broadcasts
+	the constant across every element and returns a PolynomialVector.
+*/
+func TestVariableVector_Plus9(t *testing.T) {
+	// Constants
+	N := 11
+	vv1 := symbolic.NewVariableVector(N)
+	k2 := symbolic.K(2.0)
+
+	// Test
+	r := vv1.Plus(k2)
+	pv, ok := r.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv1.Plus(k2) to return a PolynomialVector object; received %T",
+			r,
+		)
+	}
+
+	for ii, p := range pv {
+		if !symbolic.AreEqual(p, vv1[ii].Plus(k2).(symbolic.ScalarExpression), 0.0) {
+			t.Errorf(
+				"Expected element %v to be %v + %v; received %v",
+				ii,
+				vv1[ii],
+				k2,
+				p,
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Plus10
+Description:
+
+	This test verifies that adding a Variable to a variable vector broadcasts
+	the variable across every element and returns a PolynomialVector
+	whose elements each contain two monomials.
+*/
+func TestVariableVector_Plus10(t *testing.T) {
+	// Constants
+	N := 11
+	vv1 := symbolic.NewVariableVector(N)
+	v2 := symbolic.NewVariable()
+
+	// Test
+	r := vv1.Plus(v2)
+	pv, ok := r.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv1.Plus(v2) to return a PolynomialVector object; received %T",
+			r,
+		)
+	}
+
+	for ii, p := range pv {
+		if len(p.Monomials) != 2 {
+			t.Errorf(
+				"Expected element %v to have 2 monomials; received %v",
+				ii,
+				p,
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Plus11
+Description:
+
+	This test verifies that adding two variable vectors returns a
+	PolynomialVector with two monomials in each element and that adding
+	a variable vector to itself gives a single monomial with coefficient 2.
+*/
+func TestVariableVector_Plus11(t *testing.T) {
+	// Constants
+	N := 11
+	vv1 := symbolic.NewVariableVector(N)
+	vv2 := symbolic.NewVariableVector(N)
+
+	// Test
+	r := vv1.Plus(vv2)
+	pv, ok := r.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv1.Plus(vv2) to return a PolynomialVector object; received %T",
+			r,
+		)
+	}
+
+	for ii, p := range pv {
+		if len(p.Monomials) != 2 {
+			t.Errorf(
+				"Expected element %v to have 2 monomials; received %v",
+				ii,
+				p,
+			)
+		}
+	}
+
+	// Add to itself
+	r2 := vv1.Plus(vv1)
+	for ii := 0; ii < N; ii++ {
+		expected := vv1[ii].Multiply(2.0).(symbolic.ScalarExpression)
+		if !symbolic.AreEqual(r2.(symbolic.VectorExpression).AtVec(ii), expected, 0.0) {
+			t.Errorf(
+				"Expected element %v to be %v; received %v",
+				ii,
+				expected,
+				r2.(symbolic.VectorExpression).AtVec(ii),
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Plus12
+Description:
+
+	This test verifies that adding a MonomialVector to a variable vector
+	returns a PolynomialVector with the correct elements.
+*/
+func TestVariableVector_Plus12(t *testing.T) {
+	// Constants
+	N := 11
+	vv1 := symbolic.NewVariableVector(N)
+	mv2 := symbolic.NewVariableVector(N).ToMonomialVector()
+
+	// Test
+	r := vv1.Plus(mv2)
+	pv, ok := r.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv1.Plus(mv2) to return a PolynomialVector object; received %T",
+			r,
+		)
+	}
+
+	for ii, p := range pv {
+		expected := mv2[ii].Plus(vv1[ii]).(symbolic.ScalarExpression)
+		if !symbolic.AreEqual(p, expected, 0.0) {
+			t.Errorf(
+				"Expected element %v to be %v; received %v",
+				ii,
+				expected,
+				p,
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Plus13
+Description:
+
+	This test verifies that adding a PolynomialVector to a variable vector
+	returns a PolynomialVector with the correct elements.
+*/
+func TestVariableVector_Plus13(t *testing.T) {
+	// Constants
+	N := 11
+	vv1 := symbolic.NewVariableVector(N)
+	pv2 := symbolic.NewVariableVector(N).Plus(1.0).(symbolic.PolynomialVector)
+
+	// Test
+	r := vv1.Plus(pv2)
+	pv, ok := r.(symbolic.PolynomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv1.Plus(pv2) to return a PolynomialVector object; received %T",
+			r,
+		)
+	}
+
+	for ii, p := range pv {
+		if len(p.Monomials) != 3 {
+			t.Errorf(
+				"Expected element %v to have 3 monomials; received %v",
+				ii,
+				p,
+			)
+		}
+	}
+}
+
 /*
 TestVariableVector_Minus1
 Description:
@@ -1049,6 +1274,131 @@ func TestVariableVector_Multiply12(t *testing.T) {
 
 }
 
+/*
+TestVariableVector_Multiply13
+Description:
+
+	This test verifies that multiplying a variable vector of length 5 by
+	a float64 broadcasts the scalar, producing a MonomialVector whose
+	elements have the correct coefficient.
+*/
+func TestVariableVector_Multiply13(t *testing.T) {
+	// Constants
+	N := 5
+	vv1 := symbolic.NewVariableVector(N)
+
+	// Test
+	r := vv1.Multiply(2.5)
+	mv, ok := r.(symbolic.MonomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv1.Multiply(2.5) to return a MonomialVector object; received %T",
+			r,
+		)
+	}
+
+	for ii, m := range mv {
+		if m.Coefficient != 2.5 || m.VariableFactors[0].ID != vv1[ii].ID {
+			t.Errorf(
+				"Expected element %v to be 2.5 %v; received %v",
+				ii,
+				vv1[ii],
+				m,
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Multiply14
+Description:
+
+	This test verifies that a 1 x N row (the transpose of a variable vector)
+	multiplied by a variable vector of length N produces the scalar polynomial
+	representing the dot product of the two vectors.
+*/
+func TestVariableVector_Multiply14(t *testing.T) {
+	// Constants
+	N := 4
+	vv1 := symbolic.NewVariableVector(N)
+	vv2 := symbolic.NewVariableVector(N)
+
+	// Test
+	r := vv1.Transpose().Multiply(vv2)
+	p, ok := r.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf(
+			"Expected vv1.Transpose().Multiply(vv2) to return a Polynomial object; received %T",
+			r,
+		)
+	}
+
+	var expected symbolic.Expression = symbolic.K(0.0)
+	for ii := 0; ii < N; ii++ {
+		expected = expected.Plus(vv1[ii].Multiply(vv2[ii]))
+	}
+
+	if !symbolic.AreEqual(p, expected.(symbolic.ScalarExpression), 0.0) {
+		t.Errorf(
+			"Expected the dot product to be %v; received %v",
+			expected,
+			p,
+		)
+	}
+}
+
+/*
+TestVariableVector_Multiply15
+Description:
+
+	This test verifies that multiplying a variable vector of length N
+	by a 1 x M row produces the N x M outer product, and that multiplying
+	by a vector of the wrong length panics with a DimensionError.
+*/
+func TestVariableVector_Multiply15(t *testing.T) {
+	// Constants
+	N, M := 3, 2
+	vv1 := symbolic.NewVariableVector(N)
+	row2 := symbolic.NewVariableVector(M).AsRow()
+
+	// Test
+	r := vv1.Multiply(row2)
+	if r.Dims()[0] != N || r.Dims()[1] != M {
+		t.Errorf(
+			"Expected the outer product to have dimensions (%v, %v); received %v",
+			N, M,
+			r.Dims(),
+		)
+	}
+
+	for ii := 0; ii < N; ii++ {
+		for jj := 0; jj < M; jj++ {
+			expected := vv1[ii].Multiply(row2[0][jj]).(symbolic.ScalarExpression)
+			if !symbolic.AreEqual(r.At(ii, jj), expected, 0.0) {
+				t.Errorf(
+					"Expected entry (%v,%v) to be %v; received %v",
+					ii, jj,
+					expected,
+					r.At(ii, jj),
+				)
+			}
+		}
+	}
+
+	// Check the dimension error
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected vv1.Multiply(vv1) to panic; received nil")
+		}
+
+		if _, tf := r.(smErrors.DimensionError); !tf {
+			t.Errorf("Expected vv1.Multiply(vv1) to panic with a DimensionError; received %v", r)
+		}
+	}()
+	vv1.Multiply(vv1)
+}
+
 /*
 TestVariableVector_Comparison1
 Description:
@@ -1421,6 +1771,46 @@ func TestVariableVector_Transpose1(t *testing.T) {
 	}
 }
 
+/*
+TestVariableVector_Transpose3
+Description:
+
+	Verifies that transposing a VariableVector of length 5 gives an
+	expression with dimensions [1,5] whose (0,j) entry is the j-th variable,
+	and that transposing it again returns an expression with the original
+	dimensions [5,1].
+*/
+func TestVariableVector_Transpose3(t *testing.T) {
+	// Constants
+	N := 5
+	vv := symbolic.NewVariableVector(N)
+
+	// Test
+	vvT := vv.Transpose()
+	if vvT.Dims()[0] != 1 || vvT.Dims()[1] != N {
+		t.Errorf("Expected vv.Transpose() to have dimensions [1,%v]; received %v", N, vvT.Dims())
+	}
+
+	vvTAsVM, ok := vvT.(symbolic.VariableMatrix)
+	if !ok {
+		t.Errorf("Expected vv.Transpose() to be a VariableMatrix; received %T", vvT)
+	}
+
+	for jj := 0; jj < N; jj++ {
+		if vvTAsVM.At(0, jj).(symbolic.Variable).ID != vv[jj].ID {
+			t.Errorf(
+				"Expected vv.Transpose().At(0,%v) to be %v; received %v",
+				jj, vv[jj], vvTAsVM.At(0, jj),
+			)
+		}
+	}
+
+	vvTT := vvT.Transpose()
+	if vvTT.Dims()[0] != N || vvTT.Dims()[1] != 1 {
+		t.Errorf("Expected vv.Transpose().Transpose() to have dimensions [%v,1]; received %v", N, vvTT.Dims())
+	}
+}
+
 /*
 TestVariableVector_Transpose2
 Description:
@@ -1474,32 +1864,77 @@ func TestVariableVector_Transpose2(t *testing.T) {
 }
 
 /*
-TestVariableVector_String1
+TestVariableVector_AsRow1
 Description:
 
-	Verifies that the String method returns a string which contains
-	each of the variables in the vector.
+	Verifies that the AsRow method returns a 1 x N VariableMatrix
+	and that converting it back with AsColumn recovers the original vector.
 */
-func TestVariableVector_String1(t *testing.T) {
+func TestVariableVector_AsRow1(t *testing.T) {
 	// Constants
-	N := 111
+	N := 5
 	vv := symbolic.NewVariableVector(N)
 
 	// Test
-	str := vv.String()
+	row := vv.AsRow()
+	if row.Dims()[0] != 1 || row.Dims()[1] != N {
+		t.Errorf(
+			"Expected row to have dimensions (1, %v); received (%v, %v)",
+			N,
+			row.Dims()[0],
+			row.Dims()[1],
+		)
+	}
+
+	// Round trip through the column representation
+	column, err := row.Transpose().(symbolic.VariableMatrix).AsColumn()
+	if err != nil {
+		t.Errorf("Expected AsColumn to succeed; received error %v", err)
+	}
+
+	if column.Len() != N {
+		t.Errorf("Expected column to have length %v; received %v", N, column.Len())
+	}
+
 	for ii := 0; ii < N; ii++ {
-		if !strings.Contains(str, vv[ii].String()) {
+		if column[ii].ID != vv[ii].ID {
 			t.Errorf(
-				"Expected vv.String() to contain \"%v\"; received \"%v\"",
-				vv[ii].String(),
-				str,
+				"Expected element %v of the round trip to be %v; received %v",
+				ii,
+				vv[ii],
+				column[ii],
 			)
 		}
 	}
 }
 
 /*
-TestVariableVector_Power1
+TestVariableVector_String1
+Description:
+
+	Verifies that the String method returns a string which contains
+	each of the variables in the vector.
+*/
+func TestVariableVector_String1(t *testing.T) {
+	// Constants
+	N := 111
+	vv := symbolic.NewVariableVector(N)
+
+	// Test
+	str := vv.String()
+	for ii := 0; ii < N; ii++ {
+		if !strings.Contains(str, vv[ii].String()) {
+			t.Errorf(
+				"Expected vv.String() to contain \"%v\"; received \"%v\"",
+				vv[ii].String(),
+				str,
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Power1
 Description:
 
 	Verifies that the Power method returns a MonomialVector object when
@@ -1527,3 +1962,450 @@ func TestVariableVector_Power1(t *testing.T) {
 	}
 
 }
+
+/*
+TestVariableVector_Dot1
+Description:
+
+	Verifies that the Dot method of a VariableVector x with the KVector
+	(1, 2, 3) gives the affine polynomial x1 + 2 x2 + 3 x3.
+*/
+func TestVariableVector_Dot1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(3)
+	c := symbolic.KVector{1.0, 2.0, 3.0}
+
+	// Test
+	result := x.Dot(c)
+	resultAsP, ok := result.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf("Expected Dot to return a Polynomial; received %T", result)
+	}
+
+	if len(resultAsP.Monomials) != 3 {
+		t.Errorf("Expected 3 monomials; received %v", resultAsP)
+	}
+
+	if !symbolic.IsLinear(resultAsP) {
+		t.Errorf("Expected %v to be linear", resultAsP)
+	}
+
+	for ii, xII := range x {
+		mIndex := resultAsP.VariableMonomialIndex(xII)
+		if resultAsP.Monomials[mIndex].Coefficient != float64(c[ii]) {
+			t.Errorf(
+				"Expected coefficient of %v to be %v; received %v",
+				xII, c[ii], resultAsP.Monomials[mIndex].Coefficient,
+			)
+		}
+	}
+
+	// The dot product should be symmetric
+	if !symbolic.AreEqual(c.Dot(x), resultAsP, 1e-10) {
+		t.Errorf("Expected c.Dot(x) to be %v; received %v", resultAsP, c.Dot(x))
+	}
+}
+
+/*
+TestVariableVector_Dot2
+Description:
+
+	Verifies that the Dot method panics with a DimensionError when the
+	two vectors have different lengths.
+*/
+func TestVariableVector_Dot2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(3)
+	c := symbolic.KVector{1.0, 2.0}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected Dot to panic; received nil")
+		}
+
+		if _, ok := r.(smErrors.DimensionError); !ok {
+			t.Errorf("Expected Dot to panic with a DimensionError; received %T", r)
+		}
+	}()
+
+	x.Dot(c)
+}
+
+/*
+TestVariableVector_ElementWisePower1
+Description:
+
+	Verifies that squaring a VariableVector element-wise gives a MonomialVector
+	of the same length where each element is the matching variable with
+	exponent 2.
+*/
+func TestVariableVector_ElementWisePower1(t *testing.T) {
+	// Constants
+	N := 3
+	vv := symbolic.NewVariableVector(N)
+
+	// Test
+	r := vv.ElementWisePower(2)
+	rAsMV, ok := r.(symbolic.MonomialVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv.ElementWisePower(2) to return a MonomialVector object; received %T",
+			r,
+		)
+	}
+
+	if rAsMV.Len() != N {
+		t.Errorf(
+			"Expected r to have length %v; received %v",
+			N, rAsMV.Len(),
+		)
+	}
+
+	for ii, monomial := range rAsMV {
+		if len(monomial.VariableFactors) != 1 {
+			t.Errorf(
+				"Expected element %v of r to contain 1 variable; received %v",
+				ii, len(monomial.VariableFactors),
+			)
+			continue
+		}
+
+		if monomial.VariableFactors[0].ID != vv[ii].ID {
+			t.Errorf(
+				"Expected element %v of r to contain variable %v; received %v",
+				ii, vv[ii], monomial.VariableFactors[0],
+			)
+		}
+
+		if monomial.Exponents[0] != 2 {
+			t.Errorf(
+				"Expected element %v of r to have exponent 2; received %v",
+				ii, monomial.Exponents[0],
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_ElementWisePower2
+Description:
+
+	Verifies that raising a VariableVector to the power 0 element-wise gives
+	a KVector of ones with the same length.
+*/
+func TestVariableVector_ElementWisePower2(t *testing.T) {
+	// Constants
+	N := 4
+	vv := symbolic.NewVariableVector(N)
+
+	// Test
+	r := vv.ElementWisePower(0)
+	rAsKV, ok := r.(symbolic.KVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv.ElementWisePower(0) to return a KVector object; received %T",
+			r,
+		)
+	}
+
+	if rAsKV.Len() != N {
+		t.Errorf(
+			"Expected r to have length %v; received %v",
+			N, rAsKV.Len(),
+		)
+	}
+
+	for ii, element := range rAsKV {
+		if float64(element) != 1.0 {
+			t.Errorf(
+				"Expected element %v of r to be 1; received %v",
+				ii, element,
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_ElementWisePower3
+Description:
+
+	Verifies that ElementWisePower panics when given a negative exponent.
+*/
+func TestVariableVector_ElementWisePower3(t *testing.T) {
+	// Constants
+	vv := symbolic.NewVariableVector(3)
+	exponent := -1
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected vv.ElementWisePower(%v) to panic; received nil",
+				exponent,
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok {
+			t.Errorf(
+				"Expected vv.ElementWisePower(%v) to panic with an error; received %v",
+				exponent, r,
+			)
+		}
+
+		if rAsE.Error() != (smErrors.NegativeExponentError{Exponent: exponent}).Error() {
+			t.Errorf(
+				"Expected vv.ElementWisePower(%v) to panic with a NegativeExponentError; received %v",
+				exponent, rAsE,
+			)
+		}
+	}()
+
+	vv.ElementWisePower(exponent)
+}
+
+/*
+TestVariableVector_Outer1
+Description:
+
+	Verifies that the outer product of a VariableVector of length 3 with a
+	VariableVector of length 2 is a 3 x 2 MonomialMatrix whose (ii,jj) element
+	is the product x[ii] * y[jj].
+*/
+func TestVariableVector_Outer1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(3)
+	y := symbolic.NewVariableVector(2)
+
+	// Test
+	outer := x.Outer(y)
+	outerAsMM, ok := outer.(symbolic.MonomialMatrix)
+	if !ok {
+		t.Errorf(
+			"Expected x.Outer(y) to return a MonomialMatrix; received %T",
+			outer,
+		)
+	}
+
+	if outerAsMM.Dims()[0] != 3 || outerAsMM.Dims()[1] != 2 {
+		t.Errorf(
+			"Expected x.Outer(y) to have dimensions 3 x 2; received %v",
+			outerAsMM.Dims(),
+		)
+	}
+
+	for ii, row := range outerAsMM {
+		for jj, monomial := range row {
+			expected := x[ii].Multiply(y[jj]).(symbolic.ScalarExpression)
+			if !symbolic.AreEqual(monomial, expected, 1e-12) {
+				t.Errorf(
+					"Expected element (%v,%v) of x.Outer(y) to be %v; received %v",
+					ii, jj, expected, monomial,
+				)
+			}
+
+			if monomial.Degree() != 2 {
+				t.Errorf(
+					"Expected element (%v,%v) of x.Outer(y) to have degree 2; received %v",
+					ii, jj, monomial.Degree(),
+				)
+			}
+		}
+	}
+}
+
+/*
+TestVariableVector_Outer2
+Description:
+
+	Verifies that the diagonal of the outer product of a VariableVector with
+	itself contains the squares of each variable.
+*/
+func TestVariableVector_Outer2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(2)
+
+	// Test
+	outer := x.Outer(x)
+	for ii := 0; ii < x.Len(); ii++ {
+		expected := x[ii].Power(2).(symbolic.ScalarExpression)
+		if !symbolic.AreEqual(outer.At(ii, ii), expected, 1e-12) {
+			t.Errorf(
+				"Expected element (%v,%v) of x.Outer(x) to be %v; received %v",
+				ii, ii, expected, outer.At(ii, ii),
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Sum1
+Description:
+
+	Verifies that summing a VariableVector of length 4 gives a polynomial
+	with 4 monomials (one for each variable).
+*/
+func TestVariableVector_Sum1(t *testing.T) {
+	// Constants
+	vv := symbolic.NewVariableVector(4)
+
+	// Test
+	sum := vv.Sum()
+	sumAsP, ok := sum.(symbolic.Polynomial)
+	if !ok {
+		t.Errorf(
+			"Expected vv.Sum() to return a Polynomial; received %T",
+			sum,
+		)
+	}
+
+	if len(sumAsP.Monomials) != 4 {
+		t.Errorf(
+			"Expected vv.Sum() to have 4 monomials; received %v",
+			len(sumAsP.Monomials),
+		)
+	}
+
+	for ii, v := range vv {
+		if sumAsP.VariableMonomialIndex(v) == -1 {
+			t.Errorf(
+				"Expected vv.Sum() to contain element %v (%v); received %v",
+				ii, v, sumAsP,
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Sum2
+Description:
+
+	Verifies that Sum panics with an EmptyVectorError when called on an
+	empty VariableVector.
+*/
+func TestVariableVector_Sum2(t *testing.T) {
+	// Constants
+	var vv symbolic.VariableVector
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected vv.Sum() to panic; received nil",
+			)
+		}
+
+		if _, ok := r.(smErrors.EmptyVectorError); !ok {
+			t.Errorf(
+				"Expected vv.Sum() to panic with an EmptyVectorError; received %v",
+				r,
+			)
+		}
+	}()
+
+	vv.Sum()
+}
+
+/*
+TestVariableVector_Slice1
+Description:
+
+	Verifies that Slice(4, 7) of a VariableVector of length 10 is a
+	VariableVector containing the middle 3 elements.
+*/
+func TestVariableVector_Slice1(t *testing.T) {
+	// Constants
+	vv := symbolic.NewVariableVector(10)
+
+	// Test
+	sliced := vv.Slice(4, 7)
+	slicedAsVV, ok := sliced.(symbolic.VariableVector)
+	if !ok {
+		t.Errorf(
+			"Expected vv.Slice(4, 7) to return a VariableVector; received %T",
+			sliced,
+		)
+	}
+
+	if slicedAsVV.Len() != 3 {
+		t.Errorf(
+			"Expected vv.Slice(4, 7) to have length 3; received %v",
+			slicedAsVV.Len(),
+		)
+	}
+
+	for ii, v := range slicedAsVV {
+		if v.ID != vv[4+ii].ID {
+			t.Errorf(
+				"Expected element %v of vv.Slice(4, 7) to be %v; received %v",
+				ii, vv[4+ii], v,
+			)
+		}
+	}
+}
+
+/*
+TestVariableVector_Slice2
+Description:
+
+	Verifies that Slice panics with an InvalidVectorIndexError when the
+	range extends past the end of the vector.
+*/
+func TestVariableVector_Slice2(t *testing.T) {
+	// Constants
+	vv := symbolic.NewVariableVector(10)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected vv.Slice(8, 12) to panic; received nil",
+			)
+		}
+
+		if _, ok := r.(smErrors.InvalidVectorIndexError); !ok {
+			t.Errorf(
+				"Expected vv.Slice(8, 12) to panic with an InvalidVectorIndexError; received %v",
+				r,
+			)
+		}
+	}()
+
+	vv.Slice(8, 12)
+}
+
+/*
+TestVariableVector_Slice3
+Description:
+
+	Verifies that Slice panics when the range is inverted.
+*/
+func TestVariableVector_Slice3(t *testing.T) {
+	// Constants
+	vv := symbolic.NewVariableVector(10)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"Expected vv.Slice(5, 2) to panic; received nil",
+			)
+		}
+
+		rAsE, ok := r.(error)
+		if !ok || !strings.Contains(rAsE.Error(), "empty or inverted") {
+			t.Errorf(
+				"Expected vv.Slice(5, 2) to panic about an inverted range; received %v",
+				r,
+			)
+		}
+	}()
+
+	vv.Slice(5, 2)
+}
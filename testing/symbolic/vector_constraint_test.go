@@ -250,3 +250,179 @@ func TestVectorConstraint_AtVec3(t *testing.T) {
 
 	vc.AtVec(N - 1)
 }
+
+/*
+TestVectorConstraint_AtVec4
+Description:
+
+	This test verifies that AtVec(2) extracts the 3rd row of a length 5 vector
+	constraint, i.e., the 3rd entries of both sides along with the sense.
+*/
+func TestVectorConstraint_AtVec4(t *testing.T) {
+	// Constants
+	N := 5
+	left := symbolic.NewVariableVector(N)
+	right := symbolic.KVector{1.0, 2.0, 3.0, 4.0, 5.0}
+	vc := symbolic.VectorConstraint{
+		LeftHandSide:  left,
+		RightHandSide: right,
+		Sense:         symbolic.SenseGreaterThanEqual,
+	}
+
+	// Test
+	if vc.Len() != N {
+		t.Errorf("Expected vc.Len() to be %v; received %v", N, vc.Len())
+	}
+
+	sc := vc.AtVec(2)
+	lhsAsV, ok := sc.LeftHandSide.(symbolic.Variable)
+	if !ok || lhsAsV.ID != left[2].ID {
+		t.Errorf(
+			"Expected vc.AtVec(2).LeftHandSide to be %v; received %v",
+			left[2],
+			sc.LeftHandSide,
+		)
+	}
+
+	rhsAsK, ok := sc.RightHandSide.(symbolic.K)
+	if !ok || float64(rhsAsK) != 3.0 {
+		t.Errorf(
+			"Expected vc.AtVec(2).RightHandSide to be 3; received %v",
+			sc.RightHandSide,
+		)
+	}
+
+	if sc.Sense != symbolic.SenseGreaterThanEqual {
+		t.Errorf(
+			"Expected vc.AtVec(2).Sense to be >=; received %v",
+			sc.Sense,
+		)
+	}
+}
+
+/*
+TestVectorConstraint_ToScalarConstraints1
+Description:
+
+	This test verifies that the ToScalarConstraints method produces one
+	ScalarConstraint per element of a well-defined VectorConstraint and that
+	each scalar constraint reproduces the corresponding row.
+*/
+func TestVectorConstraint_ToScalarConstraints1(t *testing.T) {
+	// Constants
+	N := 4
+	left := symbolic.NewVariableVector(N)
+	right := symbolic.VecDenseToKVector(symbolic.OnesVector(N))
+	vc := symbolic.VectorConstraint{
+		LeftHandSide:  left,
+		RightHandSide: right,
+		Sense:         symbolic.SenseGreaterThanEqual,
+	}
+
+	// Test
+	scalarConstraints := vc.ToScalarConstraints()
+	if len(scalarConstraints) != N {
+		t.Errorf("Expected %v scalar constraints; received %v", N, len(scalarConstraints))
+	}
+
+	for ii, sc := range scalarConstraints {
+		if sc.LeftHandSide.(symbolic.Variable).ID != left[ii].ID {
+			t.Errorf(
+				"Expected left hand side of constraint %v to be %v; received %v",
+				ii, left[ii], sc.LeftHandSide,
+			)
+		}
+
+		if sc.RightHandSide.(symbolic.K) != right[ii] {
+			t.Errorf(
+				"Expected right hand side of constraint %v to be %v; received %v",
+				ii, right[ii], sc.RightHandSide,
+			)
+		}
+
+		if sc.Sense != symbolic.SenseGreaterThanEqual {
+			t.Errorf(
+				"Expected sense of constraint %v to be %v; received %v",
+				ii, symbolic.SenseGreaterThanEqual, sc.Sense,
+			)
+		}
+	}
+}
+
+/*
+TestVectorConstraint_ToScalarConstraints2
+Description:
+
+	This test verifies that the ToScalarConstraints method panics if the
+	two sides of the VectorConstraint have different lengths.
+*/
+func TestVectorConstraint_ToScalarConstraints2(t *testing.T) {
+	// Constants
+	N := 4
+	vc := symbolic.VectorConstraint{
+		LeftHandSide:  symbolic.NewVariableVector(N),
+		RightHandSide: symbolic.VecDenseToKVector(symbolic.OnesVector(N + 1)),
+		Sense:         symbolic.SenseEqual,
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected ToScalarConstraints to panic; received nil")
+		}
+	}()
+
+	vc.ToScalarConstraints()
+}
+
+/*
+TestVectorConstraint_StrictSenses1
+Description:
+
+	This test verifies that a VectorConstraint built with SenseLessThan or
+	SenseGreaterThan is well-defined, keeps its strict sense, and passes that
+	sense on to each of its scalar constraints.
+*/
+func TestVectorConstraint_StrictSenses1(t *testing.T) {
+	// Constants
+	N := 3
+	x := symbolic.NewVariableVector(N)
+	right := symbolic.VecDenseToKVector(symbolic.OnesVector(N))
+
+	// Test
+	for _, sense := range []symbolic.ConstrSense{symbolic.SenseLessThan, symbolic.SenseGreaterThan} {
+		vc, tf := x.Comparison(right, sense).(symbolic.VectorConstraint)
+		if !tf {
+			t.Errorf("Expected Comparison to return a VectorConstraint; received %T", x.Comparison(right, sense))
+			continue
+		}
+
+		if err := vc.Check(); err != nil {
+			t.Errorf("Expected constraint with sense %v to be well-defined; received %v", sense, err)
+		}
+
+		if vc.ConstrSense() != sense {
+			t.Errorf("Expected sense to be %v; received %v", sense, vc.ConstrSense())
+		}
+
+		for ii, sc := range vc.ToScalarConstraints() {
+			if sc.Sense != sense {
+				t.Errorf(
+					"Expected sense of constraint %v to be %v; received %v",
+					ii, sense, sc.Sense,
+				)
+			}
+
+			// Each row is violated when both sides are equal
+			satisfied, err := sc.IsSatisfiedAt(map[symbolic.Variable]float64{x[ii]: 1.0})
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if satisfied {
+				t.Errorf("Expected %v to be violated when x[%v] = 1; it was not", sc, ii)
+			}
+		}
+	}
+}
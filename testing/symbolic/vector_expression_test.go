@@ -429,3 +429,302 @@ func TestVectorExpression_VectorPowerTemplate2(t *testing.T) {
 	symbolic.VectorPowerTemplate(testVec, -1)
 	t.Errorf("Problem! The function did not panic when the input power was less than 0")
 }
+
+/*
+TestVectorExpression_CrossProduct1
+Description:
+
+	Tests that the cross product of two variable vectors of length 3
+	produces the three components given by the cross-product formula.
+*/
+func TestVectorExpression_CrossProduct1(t *testing.T) {
+	// Setup
+	a := symbolic.NewVariableVector(3)
+	b := symbolic.NewVariableVector(3)
+
+	// Test
+	c, err := symbolic.CrossProduct(a, b)
+	if err != nil {
+		t.Errorf("Expected CrossProduct to succeed; received error %v", err)
+	}
+
+	cAsPV, tf := c.(symbolic.PolynomialVector)
+	if !tf {
+		t.Errorf("Expected CrossProduct to return a PolynomialVector; received %T", c)
+	}
+
+	for ii := 0; ii < 3; ii++ {
+		jj, kk := (ii+1)%3, (ii+2)%3
+		component := cAsPV[ii]
+		if len(component.Monomials) != 2 {
+			t.Errorf(
+				"Expected component %v to have 2 monomials; received %v",
+				ii,
+				component,
+			)
+		}
+
+		// Check the positive term a_j * b_k
+		positiveTerm := a[jj].Multiply(b[kk]).(symbolic.Monomial)
+		positiveIndex := component.MonomialIndex(positiveTerm)
+		if positiveIndex == -1 || component.Monomials[positiveIndex].Coefficient != 1.0 {
+			t.Errorf(
+				"Expected component %v to contain %v with coefficient 1; received %v",
+				ii,
+				positiveTerm,
+				component,
+			)
+		}
+
+		// Check the negative term a_k * b_j
+		negativeTerm := a[kk].Multiply(b[jj]).(symbolic.Monomial)
+		negativeIndex := component.MonomialIndex(negativeTerm)
+		if negativeIndex == -1 || component.Monomials[negativeIndex].Coefficient != -1.0 {
+			t.Errorf(
+				"Expected component %v to contain %v with coefficient -1; received %v",
+				ii,
+				negativeTerm,
+				component,
+			)
+		}
+	}
+}
+
+/*
+TestVectorExpression_CrossProduct2
+Description:
+
+	Tests that the cross product returns an error when one of the
+	vectors does not have length 3.
+*/
+func TestVectorExpression_CrossProduct2(t *testing.T) {
+	// Setup
+	a := symbolic.NewVariableVector(3)
+	b := symbolic.NewVariableVector(2)
+
+	// Test
+	_, err := symbolic.CrossProduct(a, b)
+	if err == nil {
+		t.Errorf("Expected CrossProduct to return an error; received nil")
+	}
+}
+
+/*
+TestVectorExpression_WeightedInnerProduct1
+Description:
+
+	Tests that the weighted inner product of two variable vectors with
+	a constant weighting matrix matches the manual chain
+	x.Transpose().Multiply(W).Multiply(y).
+*/
+func TestVectorExpression_WeightedInnerProduct1(t *testing.T) {
+	// Setup
+	x := symbolic.NewVariableVector(2)
+	y := symbolic.NewVariableVector(2)
+	W := symbolic.KMatrix{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	// Test
+	wip := symbolic.WeightedInnerProduct(x, W, y)
+	if _, tf := wip.(symbolic.Polynomial); !tf {
+		t.Errorf("Expected WeightedInnerProduct to return a Polynomial; received %T", wip)
+	}
+
+	manual := x.Transpose().Multiply(W).Multiply(y).(symbolic.ScalarExpression)
+	if !symbolic.AreEqual(wip, manual, 1e-9) {
+		t.Errorf(
+			"Expected WeightedInnerProduct to equal the manual chain %v; received %v",
+			manual,
+			wip,
+		)
+	}
+}
+
+/*
+TestVectorExpression_WeightedInnerProduct2
+Description:
+
+	Tests that WeightedInnerProduct panics with a DimensionError when the
+	weighting matrix has the wrong number of columns.
+*/
+func TestVectorExpression_WeightedInnerProduct2(t *testing.T) {
+	// Setup
+	x := symbolic.NewVariableVector(2)
+	y := symbolic.NewVariableVector(3)
+	W := symbolic.KMatrix{
+		{1.0, 2.0},
+		{3.0, 4.0},
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected WeightedInnerProduct to panic; received nil")
+		}
+
+		if _, tf := r.(smErrors.DimensionError); !tf {
+			t.Errorf("Expected the panic to be a DimensionError; received %v", r)
+		}
+	}()
+	symbolic.WeightedInnerProduct(x, W, y)
+}
+
+/*
+TestVectorExpression_Jacobian1
+Description:
+
+	Tests that the Jacobian of the vector [x^2 + y, 3 x y] with respect to [x, y]
+	is the matrix [[2 x, 1], [3 y, 3 x]].
+*/
+func TestVectorExpression_Jacobian1(t *testing.T) {
+	// Setup
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Power(2).Plus(y).(symbolic.Polynomial),
+		x.Multiply(y).Multiply(3.0).(symbolic.Monomial).ToPolynomial(),
+	}
+
+	// Test
+	jac := symbolic.Jacobian(pv, []symbolic.Variable{x, y})
+	if jac.Dims()[0] != 2 || jac.Dims()[1] != 2 {
+		t.Errorf("Expected the Jacobian to be 2x2; received %v", jac.Dims())
+	}
+
+	expected := [][]symbolic.ScalarExpression{
+		{x.Multiply(2.0).(symbolic.ScalarExpression), symbolic.K(1.0)},
+		{y.Multiply(3.0).(symbolic.ScalarExpression), x.Multiply(3.0).(symbolic.ScalarExpression)},
+	}
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 2; jj++ {
+			if !symbolic.AreEqual(jac.At(ii, jj), expected[ii][jj], 1e-9) {
+				t.Errorf(
+					"Expected entry (%v,%v) of the Jacobian to be %v; received %v",
+					ii, jj,
+					expected[ii][jj],
+					jac.At(ii, jj),
+				)
+			}
+		}
+	}
+
+	// Verify that computing the Jacobian did not modify the input
+	if pv[0].Degree() != 2 {
+		t.Errorf("Expected the input vector to be unchanged; received %v", pv)
+	}
+}
+
+/*
+TestVectorExpression_Jacobian2
+Description:
+
+	Tests that the Jacobian panics when no variables are given.
+*/
+func TestVectorExpression_Jacobian2(t *testing.T) {
+	// Setup
+	x := symbolic.NewVariableVector(3)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("Expected Jacobian to panic with an empty wrt; received nil")
+		}
+	}()
+	symbolic.Jacobian(x, []symbolic.Variable{})
+}
+
+/*
+TestVectorExpression_EvalVector1
+Description:
+
+	Tests that EvalVector evaluates each element of a PolynomialVector
+	at the given point and returns the values in order.
+*/
+func TestVectorExpression_EvalVector1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Plus(y).(symbolic.Polynomial),
+		x.Multiply(y).(symbolic.Monomial).ToPolynomial(),
+		symbolic.K(4.0).ToPolynomial(),
+	}
+
+	// Test
+	values, err := symbolic.EvalVector(pv, map[symbolic.Variable]float64{x: 2.0, y: 3.0})
+	if err != nil {
+		t.Errorf("Expected EvalVector to succeed; received error %v", err)
+	}
+
+	expected := []float64{5.0, 6.0, 4.0}
+	for ii, e := range expected {
+		if values.AtVec(ii) != e {
+			t.Errorf("Expected element %v to be %v; received %v", ii, e, values.AtVec(ii))
+		}
+	}
+}
+
+/*
+TestVectorExpression_RunningBalance1
+Description:
+
+	Tests that RunningBalance of length-3 inflow and outflow vectors with an
+	initial level s0 produces the levels
+		s0 + u0 - w0, s0 + u0 - w0 + u1 - w1, ...
+	where the k-th level contains 2 (k+1) + 1 monomials.
+*/
+func TestVectorExpression_RunningBalance1(t *testing.T) {
+	// Constants
+	u := symbolic.NewVariableVector(3)
+	w := symbolic.NewVariableVector(3)
+	s0 := symbolic.NewVariable()
+
+	// Test
+	levels, err := symbolic.RunningBalance(u, w, s0)
+	if err != nil {
+		t.Errorf("Expected RunningBalance to succeed; received error %v", err)
+	}
+
+	if levels.Len() != 3 {
+		t.Errorf("Expected 3 levels; received %v", levels.Len())
+	}
+
+	var expected symbolic.Expression = s0
+	for k := 0; k < 3; k++ {
+		expected = expected.Plus(u[k]).Minus(w[k])
+		if !symbolic.AreEqual(levels.AtVec(k), expected.(symbolic.ScalarExpression), 1e-10) {
+			t.Errorf("Expected level %v to be %v; received %v", k, expected, levels.AtVec(k))
+		}
+
+		levelK := levels.AtVec(k).(symbolic.Polynomial)
+		if len(levelK.Monomials) != 2*(k+1)+1 {
+			t.Errorf(
+				"Expected level %v to contain %v monomials; received %v",
+				k, 2*(k+1)+1, len(levelK.Monomials),
+			)
+		}
+	}
+}
+
+/*
+TestVectorExpression_RunningBalance2
+Description:
+
+	Tests that RunningBalance returns an error when the inflow and outflow
+	vectors have different lengths.
+*/
+func TestVectorExpression_RunningBalance2(t *testing.T) {
+	// Constants
+	u := symbolic.NewVariableVector(3)
+	w := symbolic.NewVariableVector(2)
+
+	// Test
+	_, err := symbolic.RunningBalance(u, w, symbolic.K(0.0))
+	if err == nil {
+		t.Errorf("Expected RunningBalance to return an error; received nil")
+	}
+}